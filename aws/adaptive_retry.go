@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	retryModeAdaptive = "adaptive"
+	retryModeStandard = "standard"
+
+	// Requests per second allowed to a service once it first responds with a
+	// throttling error.
+	adaptiveRateLimiterInitialRate = 20.0
+	// Lowest rate the limiter will back off to.
+	adaptiveRateLimiterMinRate = 0.5
+	// Once the rate recovers past this value the limiter is disabled again.
+	adaptiveRateLimiterMaxRate = 40.0
+	// Rate increase for every successful request after throttling.
+	adaptiveRateLimiterRateIncrease = 0.5
+)
+
+// adaptiveRateLimiter is a client side token bucket which only limits the
+// request rate once the service has responded with throttling errors. Every
+// throttling error halves the allowed rate and every successful request slowly
+// increases it again, until the limiter is disabled.
+type adaptiveRateLimiter struct {
+	mu sync.Mutex
+
+	enabled    bool
+	rate       float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func (l *adaptiveRateLimiter) acquire() {
+	for {
+		l.mu.Lock()
+
+		if !l.enabled {
+			l.mu.Unlock()
+			return
+		}
+
+		l.refill(time.Now())
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return
+		}
+
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		time.Sleep(wait)
+	}
+}
+
+func (l *adaptiveRateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.lastRefill).Seconds()
+	l.tokens = math.Min(l.rate, l.tokens+elapsed*l.rate)
+	l.lastRefill = now
+}
+
+func (l *adaptiveRateLimiter) throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		l.enabled = true
+		l.rate = adaptiveRateLimiterInitialRate
+		l.tokens = 0
+		l.lastRefill = time.Now()
+		return
+	}
+
+	l.refill(time.Now())
+	l.rate = math.Max(adaptiveRateLimiterMinRate, l.rate/2)
+	l.tokens = math.Min(l.tokens, l.rate)
+}
+
+func (l *adaptiveRateLimiter) succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return
+	}
+
+	l.refill(time.Now())
+	l.rate += adaptiveRateLimiterRateIncrease
+
+	if l.rate > adaptiveRateLimiterMaxRate {
+		l.enabled = false
+	}
+}
+
+// adaptiveRateLimiters holds a rate limiter per service, so throttling by one
+// service does not slow down requests to the others.
+type adaptiveRateLimiters struct {
+	mu       sync.Mutex
+	limiters map[string]*adaptiveRateLimiter
+}
+
+func (l *adaptiveRateLimiters) get(serviceName string) *adaptiveRateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limiters == nil {
+		l.limiters = make(map[string]*adaptiveRateLimiter)
+	}
+
+	limiter, ok := l.limiters[serviceName]
+	if !ok {
+		limiter = &adaptiveRateLimiter{}
+		l.limiters[serviceName] = limiter
+	}
+
+	return limiter
+}
+
+// addAdaptiveRetryHandlers adds the handlers which rate limit requests to a
+// service after it responds with throttling errors. Retried requests keep the
+// exponential backoff and jitter of the SDK DefaultRetryer.
+func addAdaptiveRetryHandlers(handlers *request.Handlers) {
+	limiters := &adaptiveRateLimiters{}
+
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform.AdaptiveRetryAcquireHandler",
+		Fn: func(r *request.Request) {
+			limiters.get(r.ClientInfo.ServiceName).acquire()
+		},
+	})
+
+	handlers.Retry.PushFrontNamed(request.NamedHandler{
+		Name: "terraform.AdaptiveRetryThrottleHandler",
+		Fn: func(r *request.Request) {
+			if !r.IsErrorThrottle() {
+				return
+			}
+
+			log.Printf("[DEBUG] Limiting %s request rate after throttling error: %s", r.ClientInfo.ServiceName, r.Error)
+			limiters.get(r.ClientInfo.ServiceName).throttled()
+		},
+	})
+
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform.AdaptiveRetrySuccessHandler",
+		Fn: func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			limiters.get(r.ClientInfo.ServiceName).succeeded()
+		},
+	})
+}
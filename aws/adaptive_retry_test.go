@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	limiter := &adaptiveRateLimiter{}
+
+	limiter.succeeded()
+	if limiter.enabled {
+		t.Fatalf("expected limiter to be disabled before throttling")
+	}
+
+	limiter.throttled()
+	if !limiter.enabled {
+		t.Fatalf("expected limiter to be enabled after throttling")
+	}
+	if limiter.rate != adaptiveRateLimiterInitialRate {
+		t.Fatalf("expected rate %f, got %f", adaptiveRateLimiterInitialRate, limiter.rate)
+	}
+
+	limiter.throttled()
+	if expected := adaptiveRateLimiterInitialRate / 2; limiter.rate != expected {
+		t.Fatalf("expected rate %f, got %f", expected, limiter.rate)
+	}
+
+	for i := 0; i < 100; i++ {
+		limiter.throttled()
+	}
+	if limiter.rate != adaptiveRateLimiterMinRate {
+		t.Fatalf("expected rate %f, got %f", adaptiveRateLimiterMinRate, limiter.rate)
+	}
+
+	for limiter.enabled {
+		limiter.succeeded()
+	}
+	if limiter.rate <= adaptiveRateLimiterMaxRate {
+		t.Fatalf("expected rate above %f, got %f", adaptiveRateLimiterMaxRate, limiter.rate)
+	}
+}
+
+func TestAdaptiveRateLimiters(t *testing.T) {
+	limiters := &adaptiveRateLimiters{}
+
+	limiters.get("ec2").throttled()
+
+	if !limiters.get("ec2").enabled {
+		t.Fatalf("expected ec2 limiter to be enabled")
+	}
+
+	if limiters.get("route53").enabled {
+		t.Fatalf("expected route53 limiter to be disabled")
+	}
+}
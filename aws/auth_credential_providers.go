@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/go-ini/ini"
+	"github.com/hashicorp/go-cleanhttp"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	processCredentialsProviderName = "ProcessProvider"
+	ssoCredentialsProviderName     = "SSOProvider"
+
+	// Credentials are refreshed this long before they expire.
+	sharedConfigCredentialsExpiryWindow = 5 * time.Minute
+)
+
+// sharedConfigFilename returns the path of the AWS CLI shared configuration
+// file, which holds the credential_process and SSO profile settings.
+func sharedConfigFilename() (string, error) {
+	if v := os.Getenv("AWS_CONFIG_FILE"); v != "" {
+		return v, nil
+	}
+
+	return homedir.Expand("~/.aws/config")
+}
+
+// sharedConfigProfileName returns the configured profile name, falling back to
+// the AWS_PROFILE environment variable and the "default" profile.
+func sharedConfigProfileName(profile string) string {
+	if profile != "" {
+		return profile
+	}
+
+	if v := os.Getenv("AWS_PROFILE"); v != "" {
+		return v
+	}
+
+	return "default"
+}
+
+// sharedConfigProfile returns the profile section of the shared configuration
+// file. Named profiles are prefixed with "profile " in that file.
+func sharedConfigProfile(profile string) (*ini.Section, error) {
+	filename, err := sharedConfigFilename()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := ini.Load(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error loading shared config file (%s): %s", filename, err)
+	}
+
+	profile = sharedConfigProfileName(profile)
+
+	if section, err := f.GetSection("profile " + profile); err == nil {
+		return section, nil
+	}
+
+	section, err := f.GetSection(profile)
+	if err != nil {
+		return nil, fmt.Errorf("profile %q not found in shared config file (%s)", profile, filename)
+	}
+
+	return section, nil
+}
+
+// processCredentialsProvider retrieves credentials from the external command
+// configured as credential_process in the shared configuration file profile.
+type processCredentialsProvider struct {
+	awsCredentials.Expiry
+
+	Profile string
+
+	expires   bool
+	retrieved bool
+}
+
+type processCredentialsOutput struct {
+	Version         int    `json:"Version"`
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string `json:"SecretAccessKey"`
+	SessionToken    string `json:"SessionToken"`
+	Expiration      string `json:"Expiration"`
+}
+
+func (p *processCredentialsProvider) Retrieve() (awsCredentials.Value, error) {
+	value := awsCredentials.Value{ProviderName: processCredentialsProviderName}
+
+	section, err := sharedConfigProfile(p.Profile)
+	if err != nil {
+		return value, awserr.New("ProcessProviderNotConfigured", "credential_process not configured", err)
+	}
+
+	command := section.Key("credential_process").String()
+	if command == "" {
+		return value, awserr.New("ProcessProviderNotConfigured", "credential_process not configured", nil)
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Env = os.Environ()
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return value, fmt.Errorf("error running credential_process: %s: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var output processCredentialsOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return value, fmt.Errorf("error parsing credential_process output: %s", err)
+	}
+
+	if output.Version != 1 {
+		return value, fmt.Errorf("unsupported credential_process output version: %d", output.Version)
+	}
+
+	if output.AccessKeyID == "" || output.SecretAccessKey == "" {
+		return value, fmt.Errorf("credential_process output is missing AccessKeyId or SecretAccessKey")
+	}
+
+	if output.Expiration != "" {
+		expiration, err := time.Parse(time.RFC3339, output.Expiration)
+		if err != nil {
+			return value, fmt.Errorf("error parsing credential_process output Expiration: %s", err)
+		}
+
+		p.SetExpiration(expiration, sharedConfigCredentialsExpiryWindow)
+	}
+
+	p.expires = output.Expiration != ""
+	p.retrieved = true
+
+	value.AccessKeyID = output.AccessKeyID
+	value.SecretAccessKey = output.SecretAccessKey
+	value.SessionToken = output.SessionToken
+
+	return value, nil
+}
+
+// IsExpired returns if the credentials need to be retrieved again. Credentials
+// without an Expiration in the credential_process output never expire.
+func (p *processCredentialsProvider) IsExpired() bool {
+	if p.expires {
+		return p.Expiry.IsExpired()
+	}
+
+	return !p.retrieved
+}
+
+// ssoCredentialsProvider retrieves role credentials from AWS SSO, using the
+// access token cached by "aws sso login" for the shared configuration file
+// profile. HTTPClient is the client for the AWS SSO portal requests, so they
+// use the provider proxy, CA bundle and insecure settings.
+type ssoCredentialsProvider struct {
+	awsCredentials.Expiry
+
+	Profile    string
+	HTTPClient *http.Client
+}
+
+type ssoCachedToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+type ssoRoleCredentialsOutput struct {
+	RoleCredentials struct {
+		AccessKeyID     string `json:"accessKeyId"`
+		SecretAccessKey string `json:"secretAccessKey"`
+		SessionToken    string `json:"sessionToken"`
+		Expiration      int64  `json:"expiration"`
+	} `json:"roleCredentials"`
+}
+
+func (p *ssoCredentialsProvider) Retrieve() (awsCredentials.Value, error) {
+	value := awsCredentials.Value{ProviderName: ssoCredentialsProviderName}
+
+	section, err := sharedConfigProfile(p.Profile)
+	if err != nil {
+		return value, awserr.New("SSOProviderNotConfigured", "AWS SSO not configured", err)
+	}
+
+	startURL := section.Key("sso_start_url").String()
+	region := section.Key("sso_region").String()
+	accountID := section.Key("sso_account_id").String()
+	roleName := section.Key("sso_role_name").String()
+
+	if startURL == "" || region == "" || accountID == "" || roleName == "" {
+		return value, awserr.New("SSOProviderNotConfigured", "AWS SSO not configured", nil)
+	}
+
+	token, err := ssoCachedAccessToken(startURL)
+	if err != nil {
+		return value, err
+	}
+
+	query := url.Values{}
+	query.Set("account_id", accountID)
+	query.Set("role_name", roleName)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("https://portal.sso.%s.amazonaws.com/federation/credentials?%s", region, query.Encode()), nil)
+	if err != nil {
+		return value, err
+	}
+	req.Header.Set("x-amz-sso_bearer_token", token)
+
+	client := p.HTTPClient
+	if client == nil {
+		client = cleanhttp.DefaultClient()
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return value, fmt.Errorf("error getting AWS SSO role credentials: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return value, fmt.Errorf("error reading AWS SSO role credentials: %s", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return value, fmt.Errorf("error getting AWS SSO role credentials (%s): %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var output ssoRoleCredentialsOutput
+	if err := json.Unmarshal(body, &output); err != nil {
+		return value, fmt.Errorf("error parsing AWS SSO role credentials: %s", err)
+	}
+
+	p.SetExpiration(time.Unix(0, output.RoleCredentials.Expiration*int64(time.Millisecond)), sharedConfigCredentialsExpiryWindow)
+
+	value.AccessKeyID = output.RoleCredentials.AccessKeyID
+	value.SecretAccessKey = output.RoleCredentials.SecretAccessKey
+	value.SessionToken = output.RoleCredentials.SessionToken
+
+	return value, nil
+}
+
+// ssoCachedAccessToken returns the unexpired access token cached by
+// "aws sso login" for the start URL.
+func ssoCachedAccessToken(startURL string) (string, error) {
+	hash := sha1.Sum([]byte(startURL))
+
+	cacheDir, err := homedir.Expand("~/.aws/sso/cache")
+	if err != nil {
+		return "", err
+	}
+
+	filename := filepath.Join(cacheDir, hex.EncodeToString(hash[:])+".json")
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("error reading AWS SSO cached token (%s), run \"aws sso login\": %s", filename, err)
+	}
+
+	var token ssoCachedToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return "", fmt.Errorf("error parsing AWS SSO cached token (%s): %s", filename, err)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, strings.Replace(token.ExpiresAt, "UTC", "Z", 1))
+	if err != nil {
+		return "", fmt.Errorf("error parsing AWS SSO cached token (%s) expiration: %s", filename, err)
+	}
+
+	if time.Now().After(expiresAt) {
+		return "", fmt.Errorf("AWS SSO cached token (%s) expired, run \"aws sso login\"", filename)
+	}
+
+	return token.AccessToken, nil
+}
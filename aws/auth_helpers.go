@@ -14,7 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/defaults"
-	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -56,12 +56,7 @@ func GetAccountIDAndPartitionFromEC2Metadata() (string, string, error) {
 
 	cfg := &aws.Config{}
 	setOptionalEndpoint(cfg)
-	sess, err := session.NewSession(cfg)
-	if err != nil {
-		return "", "", fmt.Errorf("error creating EC2 Metadata session: %s", err)
-	}
-
-	metadataClient := ec2metadata.New(sess)
+	metadataClient := newEc2MetadataClient(cfg)
 	info, err := metadataClient.IAMInfo()
 	if err != nil {
 		// We can end up here if there's an issue with the instance metadata service
@@ -155,6 +150,11 @@ func parseAccountIDAndPartitionFromARN(inputARN string) (string, string, error)
 // environment in the case that they're not explicitly specified
 // in the Terraform configuration.
 func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
+
 	// build a chain provider, lazy-evaluated by aws-sdk
 	providers := []awsCredentials.Provider{
 		&awsCredentials.StaticProvider{Value: awsCredentials.Value{
@@ -167,6 +167,11 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 			Filename: c.CredsFilename,
 			Profile:  c.Profile,
 		},
+		&processCredentialsProvider{Profile: c.Profile},
+		&ssoCredentialsProvider{
+			Profile:    c.Profile,
+			HTTPClient: httpClient,
+		},
 	}
 
 	// Build isolated HTTP client to avoid issues with globally-shared settings
@@ -206,7 +211,7 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		// Real AWS should reply to a simple metadata request.
 		// We check it actually does to ensure something else didn't just
 		// happen to be listening on the same IP:Port
-		metadataClient := ec2metadata.New(session.New(cfg))
+		metadataClient := newEc2MetadataClient(cfg)
 		if metadataClient.Available() {
 			providers = append(providers, &ec2rolecreds.EC2RoleProvider{
 				Client: metadataClient,
@@ -229,8 +234,8 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 
 	// Otherwise we need to construct and STS client with the main credentials, and verify
 	// that we can assume the defined role.
-	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q)",
-		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy)
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, DurationSeconds: %d)",
+		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy, c.AssumeRoleDurationSeconds)
 
 	creds := awsCredentials.NewChainCredentials(providers)
 	cp, err := creds.Get()
@@ -250,11 +255,19 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		Credentials:      creds,
 		Region:           aws.String(c.Region),
 		MaxRetries:       aws.Int(c.MaxRetries),
-		HTTPClient:       cleanhttp.DefaultClient(),
+		HTTPClient:       httpClient,
 		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 	}
 
-	stsclient := sts.New(session.New(awsConfig))
+	if c.UseFipsEndpoint {
+		awsConfig.EndpointResolver = endpoints.ResolverFunc(fipsEndpointResolver)
+	}
+
+	if _, ok := isolatedPartitionForRegion(c.Region); ok {
+		awsConfig.EndpointResolver = endpoints.ResolverFunc(isolatedPartitionEndpointResolver)
+	}
+
+	stsclient := sts.New(session.New(awsConfig), c.stsConfig())
 	assumeRoleProvider := &stscreds.AssumeRoleProvider{
 		Client:  stsclient,
 		RoleARN: c.AssumeRoleARN,
@@ -268,6 +281,9 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	if c.AssumeRolePolicy != "" {
 		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
 	}
+	if c.AssumeRoleDurationSeconds != 0 {
+		assumeRoleProvider.Duration = time.Duration(c.AssumeRoleDurationSeconds) * time.Second
+	}
 
 	providers = []awsCredentials.Provider{assumeRoleProvider}
 
@@ -1,18 +1,23 @@
 package aws
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
+	homedir "github.com/mitchellh/go-homedir"
 )
 
 func TestGetAccountIDAndPartition(t *testing.T) {
@@ -715,6 +720,136 @@ func TestAWSGetCredentials_shouldBeShared(t *testing.T) {
 	}
 }
 
+var credentialProcessConfigFileContents = `[profile processprofile]
+credential_process = echo '{"Version": 1, "AccessKeyId": "processaccesskey", "SecretAccessKey": "processsecretkey"}'
+`
+
+func TestAWSGetCredentials_shouldBeProcess(t *testing.T) {
+	file, err := ioutil.TempFile(os.TempDir(), "terraform_aws_config")
+	if err != nil {
+		t.Fatalf("Error writing temporary config file: %s", err)
+	}
+	_, err = file.WriteString(credentialProcessConfigFileContents)
+	if err != nil {
+		t.Fatalf("Error writing temporary config to file: %s", err)
+	}
+	err = file.Close()
+	if err != nil {
+		t.Fatalf("Error closing temporary config file: %s", err)
+	}
+
+	defer os.Remove(file.Name())
+
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	configFile := os.Getenv("AWS_CONFIG_FILE")
+	defer os.Setenv("AWS_CONFIG_FILE", configFile)
+
+	if err := os.Setenv("AWS_CONFIG_FILE", file.Name()); err != nil {
+		t.Fatalf("Error resetting env var AWS_CONFIG_FILE: %s", err)
+	}
+
+	creds, err := GetCredentials(&Config{Profile: "processprofile", SkipMetadataApiCheck: true})
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if creds == nil {
+		t.Fatal("Expected a provider chain to be returned")
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	if v.ProviderName != processCredentialsProviderName {
+		t.Fatalf("ProviderName mismatch, expected (%s), got (%s)", processCredentialsProviderName, v.ProviderName)
+	}
+
+	if v.AccessKeyID != "processaccesskey" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "processaccesskey", v.AccessKeyID)
+	}
+
+	if v.SecretAccessKey != "processsecretkey" {
+		t.Fatalf("SecretAccessKey mismatch, expected (%s), got (%s)", "processsecretkey", v.SecretAccessKey)
+	}
+}
+
+var ssoConfigFileContents = `[profile ssoprofile]
+sso_start_url = https://example.awsapps.com/start
+sso_region = us-east-1
+sso_account_id = 123456789012
+sso_role_name = Example
+`
+
+func TestSSOCredentialsProvider_httpClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform_aws_sso")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	configFile := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(configFile, []byte(ssoConfigFileContents), 0600); err != nil {
+		t.Fatalf("Error writing temporary config file: %s", err)
+	}
+
+	cacheDir := filepath.Join(dir, ".aws", "sso", "cache")
+	if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		t.Fatalf("Error creating SSO cache directory: %s", err)
+	}
+
+	hash := sha1.Sum([]byte("https://example.awsapps.com/start"))
+	token := fmt.Sprintf(`{"accessToken": "token", "expiresAt": %q}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	if err := ioutil.WriteFile(filepath.Join(cacheDir, hex.EncodeToString(hash[:])+".json"), []byte(token), 0600); err != nil {
+		t.Fatalf("Error writing SSO cached token: %s", err)
+	}
+
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	oldConfigFile := os.Getenv("AWS_CONFIG_FILE")
+	defer os.Setenv("AWS_CONFIG_FILE", oldConfigFile)
+	os.Setenv("AWS_CONFIG_FILE", configFile)
+
+	oldHome := os.Getenv("HOME")
+	defer os.Setenv("HOME", oldHome)
+	os.Setenv("HOME", dir)
+
+	homedir.DisableCache = true
+	defer func() { homedir.DisableCache = false }()
+
+	// The proxy refuses every request, recording the host of the tunnel.
+	var connectHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "CONNECT" {
+			connectHost = r.Host
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer proxy.Close()
+
+	c := &Config{HTTPSProxy: proxy.URL}
+	client, err := c.httpClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	p := &ssoCredentialsProvider{
+		Profile:    "ssoprofile",
+		HTTPClient: client,
+	}
+
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatalf("expected error")
+	}
+
+	if expected := "portal.sso.us-east-1.amazonaws.com:443"; connectHost != expected {
+		t.Fatalf("expected AWS SSO request through https_proxy to %q, got: %q", expected, connectHost)
+	}
+}
+
 func TestAWSGetCredentials_shouldBeENV(t *testing.T) {
 	// need to set the environment variables to a dummy string, as we don't know
 	// what they may be at runtime without hardcoding here
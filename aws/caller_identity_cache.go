@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// callerIdentity is the account ID and partition of the provider credentials.
+// validated is set when the credentials were validated via STS.
+type callerIdentity struct {
+	accountID string
+	partition string
+	validated bool
+}
+
+// callerIdentityCache holds the caller identity per set of credentials, so
+// provider configurations sharing credentials, e.g. aliases for other regions
+// assuming the same role, only look it up once.
+var callerIdentityCache = struct {
+	sync.Mutex
+	identities map[string]callerIdentity
+}{
+	identities: make(map[string]callerIdentity),
+}
+
+// callerIdentityCacheKey returns the cache key of the credentials. Assumed
+// role credentials are identified by the role, as assuming it already
+// verified the source credentials. Other credentials are identified by a hash
+// of the whole credentials, so a configuration with the same access key ID
+// but a different secret key or session token does not reuse an identity
+// validated with other credentials. An empty key means the identity must not
+// be cached.
+func callerIdentityCacheKey(c *Config, creds awsCredentials.Value) string {
+	if c.AssumeRoleARN != "" {
+		return "role:" + c.AssumeRoleARN + ":" + c.AssumeRoleExternalID
+	}
+
+	if creds.AccessKeyID == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(creds.AccessKeyID + "\x00" + creds.SecretAccessKey + "\x00" + creds.SessionToken))
+
+	return fmt.Sprintf("key:%s:%x", creds.AccessKeyID, hash)
+}
+
+func getCachedCallerIdentity(key string) (callerIdentity, bool) {
+	if key == "" {
+		return callerIdentity{}, false
+	}
+
+	callerIdentityCache.Lock()
+	defer callerIdentityCache.Unlock()
+
+	identity, ok := callerIdentityCache.identities[key]
+
+	return identity, ok
+}
+
+func setCachedCallerIdentity(key string, identity callerIdentity) {
+	if key == "" || identity.accountID == "" {
+		return
+	}
+
+	callerIdentityCache.Lock()
+	defer callerIdentityCache.Unlock()
+
+	callerIdentityCache.identities[key] = identity
+}
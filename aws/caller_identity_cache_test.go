@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+func TestCallerIdentityCacheKey(t *testing.T) {
+	cases := []struct {
+		Config      *Config
+		Credentials awsCredentials.Value
+		Expected    string
+	}{
+		{
+			Config:      &Config{},
+			Credentials: awsCredentials.Value{},
+			Expected:    "",
+		},
+		{
+			Config: &Config{
+				AssumeRoleARN:        "arn:aws:iam::123456789012:role/example",
+				AssumeRoleExternalID: "external",
+			},
+			Credentials: awsCredentials.Value{
+				AccessKeyID:     "ASIAEXAMPLE",
+				SecretAccessKey: "secret",
+				SessionToken:    "token",
+			},
+			Expected: "role:arn:aws:iam::123456789012:role/example:external",
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := callerIdentityCacheKey(tc.Config, tc.Credentials); actual != tc.Expected {
+			t.Fatalf("%d: expected %q, got %q", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestCallerIdentityCacheKey_credentials(t *testing.T) {
+	creds := awsCredentials.Value{
+		AccessKeyID:     "AKIAEXAMPLE",
+		SecretAccessKey: "secret",
+	}
+
+	key := callerIdentityCacheKey(&Config{}, creds)
+	if !strings.HasPrefix(key, "key:AKIAEXAMPLE:") {
+		t.Fatalf("expected key for access key ID, got %q", key)
+	}
+	if strings.Contains(key, creds.SecretAccessKey) {
+		t.Fatalf("expected key not to contain the secret access key, got %q", key)
+	}
+
+	if actual := callerIdentityCacheKey(&Config{}, creds); actual != key {
+		t.Fatalf("expected same key for same credentials, got %q and %q", key, actual)
+	}
+
+	otherSecret := creds
+	otherSecret.SecretAccessKey = "other"
+	if actual := callerIdentityCacheKey(&Config{}, otherSecret); actual == key {
+		t.Fatalf("expected different key for different secret access key, got %q", actual)
+	}
+
+	otherToken := creds
+	otherToken.SessionToken = "token"
+	if actual := callerIdentityCacheKey(&Config{}, otherToken); actual == key {
+		t.Fatalf("expected different key for different session token, got %q", actual)
+	}
+}
+
+func TestCachedCallerIdentity(t *testing.T) {
+	key := "key:AKIATESTCACHEDCALLERIDENTITY"
+
+	if _, ok := getCachedCallerIdentity(key); ok {
+		t.Fatalf("expected no cached identity")
+	}
+
+	// Unknown account IDs are not cached
+	setCachedCallerIdentity(key, callerIdentity{partition: "aws"})
+	if _, ok := getCachedCallerIdentity(key); ok {
+		t.Fatalf("expected no cached identity")
+	}
+
+	expected := callerIdentity{
+		accountID: "123456789012",
+		partition: "aws",
+		validated: true,
+	}
+	setCachedCallerIdentity(key, expected)
+
+	actual, ok := getCachedCallerIdentity(key)
+	if !ok {
+		t.Fatalf("expected cached identity")
+	}
+	if actual != expected {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	if _, ok := getCachedCallerIdentity(""); ok {
+		t.Fatalf("expected no cached identity for empty key")
+	}
+}
@@ -2,10 +2,14 @@ package aws
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -38,6 +42,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
@@ -79,6 +84,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -95,6 +101,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/go-cleanhttp"
@@ -111,46 +119,44 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
+	RetryMode         string
+	ServiceMaxRetries map[string]int
+
 	AssumeRoleARN         string
 	AssumeRoleExternalID  string
 	AssumeRoleSessionName string
 	AssumeRolePolicy      string
 
+	AssumeRoleDurationSeconds int
+
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	AcmEndpoint              string
-	ApigatewayEndpoint       string
-	CloudFormationEndpoint   string
-	CloudWatchEndpoint       string
-	CloudWatchEventsEndpoint string
-	CloudWatchLogsEndpoint   string
-	DynamoDBEndpoint         string
-	DeviceFarmEndpoint       string
-	Ec2Endpoint              string
-	EcsEndpoint              string
-	AutoscalingEndpoint      string
-	EcrEndpoint              string
-	EfsEndpoint              string
-	EsEndpoint               string
-	ElbEndpoint              string
-	IamEndpoint              string
-	KinesisEndpoint          string
-	KmsEndpoint              string
-	LambdaEndpoint           string
-	RdsEndpoint              string
-	R53Endpoint              string
-	S3Endpoint               string
-	SnsEndpoint              string
-	SqsEndpoint              string
-	StsEndpoint              string
-	SsmEndpoint              string
-	Insecure                 bool
+	DefaultTags           map[string]interface{}
+	IgnoreTagsKeys        []*string
+	IgnoreTagsKeyPrefixes []*string
+
+	Endpoints map[string]string
+	Insecure  bool
+
+	UseDualStackEndpoint bool
+	UseFipsEndpoint      bool
+
+	StsRegion            string
+	StsRegionalEndpoints string
+
+	CABundle   string
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+
+	LogHTTPBodies bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
 	SkipRegionValidation    bool
 	SkipRequestingAccountId bool
+	SkipCallerIdentityCache bool
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
 }
@@ -166,6 +172,7 @@ type AWSClient struct {
 	cognitoconn           *cognitoidentity.CognitoIdentity
 	cognitoidpconn        *cognitoidentityprovider.CognitoIdentityProvider
 	configconn            *configservice.ConfigService
+	curconn               *costandusagereportservice.CostandUsageReportService
 	daxconn               *dax.DAX
 	devicefarmconn        *devicefarm.DeviceFarm
 	dmsconn               *databasemigrationservice.DatabaseMigrationService
@@ -194,9 +201,15 @@ type AWSClient struct {
 	snsconn               *sns.SNS
 	stsconn               *sts.STS
 	redshiftconn          *redshift.Redshift
+	resourcegroupsconn    *resourcegroups.ResourceGroups
 	r53conn               *route53.Route53
 	partition             string
 	accountid             string
+	defaultTags           map[string]interface{}
+	ignoreTagsKeys        []*string
+	ignoreTagsKeyPrefixes []*string
+	elbTagsBatcher        *tagsBatcher
+	elbv2TagsBatcher      *tagsBatcher
 	supportedplatforms    []string
 	region                string
 	rdsconn               *rds.RDS
@@ -229,6 +242,8 @@ type AWSClient struct {
 	swfconn               *swf.SWF
 	wafconn               *waf.WAF
 	wafregionalconn       *wafregional.WAFRegional
+	workspacesconn        *workspaces.WorkSpaces
+	xrayconn              *xray.XRay
 	iotconn               *iot.IoT
 	batchconn             *batch.Batch
 	glueconn              *glue.Glue
@@ -273,6 +288,9 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.defaultTags = c.DefaultTags
+	client.ignoreTagsKeys = c.IgnoreTagsKeys
+	client.ignoreTagsKeyPrefixes = c.IgnoreTagsKeyPrefixes
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -280,6 +298,11 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
+
 	// define the AWS Session options
 	// Credentials or Profile will be set in the Options below
 	// MaxRetries may be set once we validate credentials
@@ -287,7 +310,7 @@ func (c *Config) Client() (interface{}, error) {
 		Config: aws.Config{
 			Region:           aws.String(c.Region),
 			MaxRetries:       aws.Int(0),
-			HTTPClient:       cleanhttp.DefaultClient(),
+			HTTPClient:       httpClient,
 			S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 		},
 	}
@@ -328,17 +351,27 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	if logging.IsDebugOrHigher() {
-		opt.Config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
-		opt.Config.Logger = awsLogger{}
-	}
+		opt.Config.LogLevel = aws.LogLevel(aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		opt.Config.Logger = awsLogger{level: "DEBUG"}
 
-	if c.Insecure {
-		transport := opt.Config.HTTPClient.Transport.(*http.Transport)
-		transport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: true,
+		if c.LogHTTPBodies && logging.LogLevel() == "TRACE" {
+			opt.Config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+			opt.Config.Logger = awsLogger{level: "TRACE"}
 		}
 	}
 
+	if c.UseDualStackEndpoint {
+		opt.Config.UseDualStack = aws.Bool(true)
+	}
+
+	if c.UseFipsEndpoint {
+		opt.Config.EndpointResolver = endpoints.ResolverFunc(fipsEndpointResolver)
+	}
+
+	if _, ok := isolatedPartitionForRegion(c.Region); ok {
+		opt.Config.EndpointResolver = endpoints.ResolverFunc(isolatedPartitionEndpointResolver)
+	}
+
 	// create base session with no retries. MaxRetries will be set later
 	sess, err := session.NewSessionWithOptions(opt)
 	if err != nil {
@@ -361,6 +394,11 @@ func (c *Config) Client() (interface{}, error) {
 		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
 	}
 
+	if c.RetryMode == retryModeAdaptive {
+		log.Printf("[INFO] Using adaptive retry mode")
+		addAdaptiveRetryHandlers(&sess.Handlers)
+	}
+
 	// Generally, we want to configure a lower retry theshold for networking issues
 	// as the session retry threshold is very high by default and can mask permanent
 	// networking failures, such as a non-existent service endpoint.
@@ -391,71 +429,68 @@ func (c *Config) Client() (interface{}, error) {
 	// Other resources that have restrictions should allow the API to fail, rather
 	// than Terraform abstracting the region for the user. This can lead to breaking
 	// changes if that resource is ever opened up to more regions.
-	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.R53Endpoint)})
-
-	// Some services have user-configurable endpoints
-	awsAcmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.AcmEndpoint)})
-	awsApigatewaySess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ApigatewayEndpoint)})
-	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
-	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
-	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
-	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
-	awsDynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
-	awsEc2Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.Ec2Endpoint)})
-	awsAutoscalingSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.AutoscalingEndpoint)})
-	awsEcrSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcrEndpoint)})
-	awsEcsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcsEndpoint)})
-	awsEfsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EfsEndpoint)})
-	awsElbSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ElbEndpoint)})
-	awsEsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EsEndpoint)})
-	awsIamSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.IamEndpoint)})
-	awsLambdaSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.LambdaEndpoint)})
-	awsKinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
-	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
-	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
-	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
-	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
-	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
-	awsStsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.StsEndpoint)})
-	awsDeviceFarmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DeviceFarmEndpoint)})
-	awsSsmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SsmEndpoint)})
+	// The isolated partitions have no us-east-1 region.
+	r53Region := "us-east-1"
+	if _, ok := isolatedPartitionForRegion(c.Region); ok {
+		r53Region = c.Region
+	}
+	r53Sess := sess.Copy(c.serviceConfig("r53").WithRegion(r53Region))
 
 	log.Println("[INFO] Initializing DeviceFarm SDK connection")
-	client.devicefarmconn = devicefarm.New(awsDeviceFarmSess)
+	client.devicefarmconn = devicefarm.New(sess.Copy(c.serviceConfig("devicefarm")))
 
 	// Beyond verifying credentials (if enabled), we use the next set of logic
 	// to determine two pieces of information required for manually assembling
 	// resource ARNs when they are not available in the service API:
 	//  * client.accountid
 	//  * client.partition
-	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(awsStsSess)
+	client.iamconn = iam.New(sess.Copy(c.serviceConfig("iam")))
+	client.stsconn = sts.New(sess.Copy(c.stsConfig()))
 
-	if c.AssumeRoleARN != "" {
-		client.accountid, client.partition, _ = parseAccountIDAndPartitionFromARN(c.AssumeRoleARN)
+	callerIdentityKey := ""
+	if !c.SkipCallerIdentityCache {
+		callerIdentityKey = callerIdentityCacheKey(c, cp)
 	}
 
-	// Validate credentials early and fail before we do any graph walking.
-	if !c.SkipCredsValidation {
-		var err error
-		client.accountid, client.partition, err = GetAccountIDAndPartitionFromSTSGetCallerIdentity(client.stsconn)
-		if err != nil {
-			return nil, fmt.Errorf("error validating provider credentials: %s", err)
+	if identity, ok := getCachedCallerIdentity(callerIdentityKey); ok && (identity.validated || c.SkipCredsValidation) {
+		// The credentials were already validated by another provider
+		// configuration in this process.
+		log.Printf("[DEBUG] Using cached AWS account ID (%s) and partition (%s)", identity.accountID, identity.partition)
+		client.accountid = identity.accountID
+		client.partition = identity.partition
+	} else {
+		if c.AssumeRoleARN != "" {
+			client.accountid, client.partition, _ = parseAccountIDAndPartitionFromARN(c.AssumeRoleARN)
 		}
-	}
 
-	if client.accountid == "" && !c.SkipRequestingAccountId {
-		var err error
-		client.accountid, client.partition, err = GetAccountIDAndPartition(client.iamconn, client.stsconn, cp.ProviderName)
-		if err != nil {
-			// DEPRECATED: Next major version of the provider should return the error instead of logging
-			//             if skip_request_account_id is not enabled.
-			log.Printf("[WARN] %s", fmt.Sprintf(
-				"AWS account ID not previously found and failed retrieving via all available methods. "+
-					"This will return an error in the next major version of the AWS provider. "+
-					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
-					"Errors: %s", err))
+		// Validate credentials early and fail before we do any graph walking.
+		if !c.SkipCredsValidation {
+			var err error
+			client.accountid, client.partition, err = GetAccountIDAndPartitionFromSTSGetCallerIdentity(client.stsconn)
+			if err != nil {
+				return nil, fmt.Errorf("error validating provider credentials: %s", err)
+			}
 		}
+
+		if client.accountid == "" && !c.SkipRequestingAccountId {
+			var err error
+			client.accountid, client.partition, err = GetAccountIDAndPartition(client.iamconn, client.stsconn, cp.ProviderName)
+			if err != nil {
+				// DEPRECATED: Next major version of the provider should return the error instead of logging
+				//             if skip_request_account_id is not enabled.
+				log.Printf("[WARN] %s", fmt.Sprintf(
+					"AWS account ID not previously found and failed retrieving via all available methods. "+
+						"This will return an error in the next major version of the AWS provider. "+
+						"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+						"Errors: %s", err))
+			}
+		}
+
+		setCachedCallerIdentity(callerIdentityKey, callerIdentity{
+			accountID: client.accountid,
+			partition: client.partition,
+			validated: !c.SkipCredsValidation,
+		})
 	}
 
 	if client.accountid == "" {
@@ -469,12 +504,12 @@ func (c *Config) Client() (interface{}, error) {
 
 	// Infer AWS partition from configured region if we still need it
 	if client.partition == "" {
-		if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), client.region); ok {
-			client.partition = partition.ID()
+		if partition, ok := partitionForRegion(client.region); ok {
+			client.partition = partition
 		}
 	}
 
-	client.ec2conn = ec2.New(awsEc2Sess)
+	client.ec2conn = ec2.New(sess.Copy(c.serviceConfig("ec2")))
 
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
@@ -487,83 +522,89 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	client.budgetconn = budgets.New(sess)
-	client.acmconn = acm.New(awsAcmSess)
-	client.acmpcaconn = acmpca.New(sess)
-	client.apigateway = apigateway.New(awsApigatewaySess)
-	client.appautoscalingconn = applicationautoscaling.New(sess)
-	client.autoscalingconn = autoscaling.New(awsAutoscalingSess)
-	client.cloud9conn = cloud9.New(sess)
-	client.cfconn = cloudformation.New(awsCfSess)
-	client.cloudfrontconn = cloudfront.New(sess)
-	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(awsCwSess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
-	client.codecommitconn = codecommit.New(sess)
-	client.codebuildconn = codebuild.New(sess)
-	client.codedeployconn = codedeploy.New(sess)
-	client.configconn = configservice.New(sess)
-	client.cognitoconn = cognitoidentity.New(sess)
-	client.cognitoidpconn = cognitoidentityprovider.New(sess)
-	client.codepipelineconn = codepipeline.New(sess)
-	client.daxconn = dax.New(awsDynamoSess)
-	client.dmsconn = databasemigrationservice.New(sess)
-	client.dsconn = directoryservice.New(sess)
-	client.dynamodbconn = dynamodb.New(awsDynamoSess)
-	client.ecrconn = ecr.New(awsEcrSess)
-	client.ecsconn = ecs.New(awsEcsSess)
-	client.efsconn = efs.New(awsEfsSess)
-	client.eksconn = eks.New(sess)
-	client.elasticacheconn = elasticache.New(sess)
-	client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
-	client.elastictranscoderconn = elastictranscoder.New(sess)
-	client.elbconn = elb.New(awsElbSess)
-	client.elbv2conn = elbv2.New(awsElbSess)
-	client.emrconn = emr.New(sess)
-	client.esconn = elasticsearch.New(awsEsSess)
-	client.firehoseconn = firehose.New(sess)
-	client.fmsconn = fms.New(sess)
-	client.inspectorconn = inspector.New(sess)
-	client.gameliftconn = gamelift.New(sess)
-	client.glacierconn = glacier.New(sess)
-	client.guarddutyconn = guardduty.New(sess)
-	client.iotconn = iot.New(sess)
-	client.kinesisconn = kinesis.New(awsKinesisSess)
-	client.kmsconn = kms.New(awsKmsSess)
-	client.lambdaconn = lambda.New(awsLambdaSess)
-	client.lexmodelconn = lexmodelbuildingservice.New(sess)
-	client.lightsailconn = lightsail.New(sess)
-	client.macieconn = macie.New(sess)
-	client.mqconn = mq.New(sess)
-	client.neptuneconn = neptune.New(sess)
-	client.opsworksconn = opsworks.New(sess)
-	client.organizationsconn = organizations.New(sess)
+	client.budgetconn = budgets.New(sess.Copy(c.serviceConfig("budgets")))
+	client.acmconn = acm.New(sess.Copy(c.serviceConfig("acm")))
+	client.acmpcaconn = acmpca.New(sess.Copy(c.serviceConfig("acmpca")))
+	client.apigateway = apigateway.New(sess.Copy(c.serviceConfig("apigateway")))
+	client.appautoscalingconn = applicationautoscaling.New(sess.Copy(c.serviceConfig("applicationautoscaling")))
+	client.autoscalingconn = autoscaling.New(sess.Copy(c.serviceConfig("autoscaling")))
+	client.cloud9conn = cloud9.New(sess.Copy(c.serviceConfig("cloud9")))
+	client.cfconn = cloudformation.New(sess.Copy(c.serviceConfig("cloudformation")))
+	client.cloudfrontconn = cloudfront.New(sess.Copy(c.serviceConfig("cloudfront")))
+	client.cloudtrailconn = cloudtrail.New(sess.Copy(c.serviceConfig("cloudtrail")))
+	client.cloudwatchconn = cloudwatch.New(sess.Copy(c.serviceConfig("cloudwatch")))
+	client.cloudwatcheventsconn = cloudwatchevents.New(sess.Copy(c.serviceConfig("cloudwatchevents")))
+	client.cloudwatchlogsconn = cloudwatchlogs.New(sess.Copy(c.serviceConfig("cloudwatchlogs")))
+	client.codecommitconn = codecommit.New(sess.Copy(c.serviceConfig("codecommit")))
+	client.codebuildconn = codebuild.New(sess.Copy(c.serviceConfig("codebuild")))
+	client.codedeployconn = codedeploy.New(sess.Copy(c.serviceConfig("codedeploy")))
+	client.configconn = configservice.New(sess.Copy(c.serviceConfig("configservice")))
+	// The Cost and Usage Report API is only available in us-east-1.
+	client.curconn = costandusagereportservice.New(sess.Copy(c.serviceConfig("cur").WithRegion("us-east-1")))
+	client.cognitoconn = cognitoidentity.New(sess.Copy(c.serviceConfig("cognitoidentity")))
+	client.cognitoidpconn = cognitoidentityprovider.New(sess.Copy(c.serviceConfig("cognitoidp")))
+	client.codepipelineconn = codepipeline.New(sess.Copy(c.serviceConfig("codepipeline")))
+	client.daxconn = dax.New(sess.Copy(c.serviceConfig("dax")))
+	client.dmsconn = databasemigrationservice.New(sess.Copy(c.serviceConfig("dms")))
+	client.dsconn = directoryservice.New(sess.Copy(c.serviceConfig("ds")))
+	client.dynamodbconn = dynamodb.New(sess.Copy(c.serviceConfig("dynamodb")))
+	client.ecrconn = ecr.New(sess.Copy(c.serviceConfig("ecr")))
+	client.ecsconn = ecs.New(sess.Copy(c.serviceConfig("ecs")))
+	client.efsconn = efs.New(sess.Copy(c.serviceConfig("efs")))
+	client.eksconn = eks.New(sess.Copy(c.serviceConfig("eks")))
+	client.elasticacheconn = elasticache.New(sess.Copy(c.serviceConfig("elasticache")))
+	client.elasticbeanstalkconn = elasticbeanstalk.New(sess.Copy(c.serviceConfig("elasticbeanstalk")))
+	client.elastictranscoderconn = elastictranscoder.New(sess.Copy(c.serviceConfig("elastictranscoder")))
+	client.elbconn = elb.New(sess.Copy(c.serviceConfig("elb")))
+	client.elbv2conn = elbv2.New(sess.Copy(c.serviceConfig("elb")))
+	client.elbTagsBatcher = newElbTagsBatcher(client.elbconn)
+	client.elbv2TagsBatcher = newElbv2TagsBatcher(client.elbv2conn)
+	client.emrconn = emr.New(sess.Copy(c.serviceConfig("emr")))
+	client.esconn = elasticsearch.New(sess.Copy(c.serviceConfig("es")))
+	client.firehoseconn = firehose.New(sess.Copy(c.serviceConfig("firehose")))
+	client.fmsconn = fms.New(sess.Copy(c.serviceConfig("fms")))
+	client.inspectorconn = inspector.New(sess.Copy(c.serviceConfig("inspector")))
+	client.gameliftconn = gamelift.New(sess.Copy(c.serviceConfig("gamelift")))
+	client.glacierconn = glacier.New(sess.Copy(c.serviceConfig("glacier")))
+	client.guarddutyconn = guardduty.New(sess.Copy(c.serviceConfig("guardduty")))
+	client.iotconn = iot.New(sess.Copy(c.serviceConfig("iot")))
+	client.kinesisconn = kinesis.New(sess.Copy(c.serviceConfig("kinesis")))
+	client.kmsconn = kms.New(sess.Copy(c.serviceConfig("kms")))
+	client.lambdaconn = lambda.New(sess.Copy(c.serviceConfig("lambda")))
+	client.lexmodelconn = lexmodelbuildingservice.New(sess.Copy(c.serviceConfig("lexmodels")))
+	client.lightsailconn = lightsail.New(sess.Copy(c.serviceConfig("lightsail")))
+	client.macieconn = macie.New(sess.Copy(c.serviceConfig("macie")))
+	client.mqconn = mq.New(sess.Copy(c.serviceConfig("mq")))
+	client.neptuneconn = neptune.New(sess.Copy(c.serviceConfig("neptune")))
+	client.opsworksconn = opsworks.New(sess.Copy(c.serviceConfig("opsworks")))
+	client.organizationsconn = organizations.New(sess.Copy(c.serviceConfig("organizations")))
 	client.r53conn = route53.New(r53Sess)
-	client.rdsconn = rds.New(awsRdsSess)
-	client.redshiftconn = redshift.New(sess)
-	client.simpledbconn = simpledb.New(sess)
-	client.s3conn = s3.New(awsS3Sess)
-	client.scconn = servicecatalog.New(sess)
-	client.sdconn = servicediscovery.New(sess)
-	client.sesConn = ses.New(sess)
-	client.secretsmanagerconn = secretsmanager.New(sess)
-	client.sfnconn = sfn.New(sess)
-	client.snsconn = sns.New(awsSnsSess)
-	client.sqsconn = sqs.New(awsSqsSess)
-	client.ssmconn = ssm.New(awsSsmSess)
-	client.storagegatewayconn = storagegateway.New(sess)
-	client.swfconn = swf.New(sess)
-	client.wafconn = waf.New(sess)
-	client.wafregionalconn = wafregional.New(sess)
-	client.batchconn = batch.New(sess)
-	client.glueconn = glue.New(sess)
-	client.athenaconn = athena.New(sess)
-	client.dxconn = directconnect.New(sess)
-	client.mediastoreconn = mediastore.New(sess)
-	client.appsyncconn = appsync.New(sess)
-	client.neptuneconn = neptune.New(sess)
-	client.pricingconn = pricing.New(sess)
+	client.rdsconn = rds.New(sess.Copy(c.serviceConfig("rds")))
+	client.redshiftconn = redshift.New(sess.Copy(c.serviceConfig("redshift")))
+	client.resourcegroupsconn = resourcegroups.New(sess.Copy(c.serviceConfig("resourcegroups")))
+	client.simpledbconn = simpledb.New(sess.Copy(c.serviceConfig("sdb")))
+	client.s3conn = s3.New(sess.Copy(c.serviceConfig("s3")))
+	client.scconn = servicecatalog.New(sess.Copy(c.serviceConfig("servicecatalog")))
+	client.sdconn = servicediscovery.New(sess.Copy(c.serviceConfig("servicediscovery")))
+	client.sesConn = ses.New(sess.Copy(c.serviceConfig("ses")))
+	client.secretsmanagerconn = secretsmanager.New(sess.Copy(c.serviceConfig("secretsmanager")))
+	client.sfnconn = sfn.New(sess.Copy(c.serviceConfig("sfn")))
+	client.snsconn = sns.New(sess.Copy(c.serviceConfig("sns")))
+	client.sqsconn = sqs.New(sess.Copy(c.serviceConfig("sqs")))
+	client.ssmconn = ssm.New(sess.Copy(c.serviceConfig("ssm")))
+	client.storagegatewayconn = storagegateway.New(sess.Copy(c.serviceConfig("storagegateway")))
+	client.swfconn = swf.New(sess.Copy(c.serviceConfig("swf")))
+	client.wafconn = waf.New(sess.Copy(c.serviceConfig("waf")))
+	client.wafregionalconn = wafregional.New(sess.Copy(c.serviceConfig("wafregional")))
+	client.workspacesconn = workspaces.New(sess.Copy(c.serviceConfig("workspaces")))
+	client.xrayconn = xray.New(sess.Copy(c.serviceConfig("xray")))
+	client.batchconn = batch.New(sess.Copy(c.serviceConfig("batch")))
+	client.glueconn = glue.New(sess.Copy(c.serviceConfig("glue")))
+	client.athenaconn = athena.New(sess.Copy(c.serviceConfig("athena")))
+	client.dxconn = directconnect.New(sess.Copy(c.serviceConfig("directconnect")))
+	client.mediastoreconn = mediastore.New(sess.Copy(c.serviceConfig("mediastore")))
+	client.appsyncconn = appsync.New(sess.Copy(c.serviceConfig("appsync")))
+	client.pricingconn = pricing.New(sess.Copy(c.serviceConfig("pricing")))
 
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
 	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
@@ -626,6 +667,198 @@ func (c *Config) Client() (interface{}, error) {
 	return &client, nil
 }
 
+// httpClient returns the HTTP client for AWS API requests, configured with the
+// provider proxy, CA bundle and insecure settings.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+
+	if c.HTTPProxy != "" || c.HTTPSProxy != "" || c.NoProxy != "" {
+		proxy, err := c.proxyFunc()
+		if err != nil {
+			return nil, err
+		}
+
+		transport.Proxy = proxy
+	}
+
+	if c.CABundle != "" || c.Insecure {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+
+	if c.CABundle != "" {
+		pem, err := ioutil.ReadFile(c.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ca_bundle (%s): %s", c.CABundle, err)
+		}
+
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error reading ca_bundle (%s): no PEM encoded certificates found", c.CABundle)
+		}
+
+		transport.TLSClientConfig.RootCAs = rootCAs
+	}
+
+	if c.Insecure {
+		transport.TLSClientConfig.InsecureSkipVerify = true
+	}
+
+	return client, nil
+}
+
+// proxyFunc returns the proxy selection for the provider http_proxy,
+// https_proxy and no_proxy settings, which replace the proxy environment
+// variables.
+func (c *Config) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	var httpProxy, httpsProxy *url.URL
+	var err error
+
+	if c.HTTPProxy != "" {
+		httpProxy, err = url.Parse(c.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing http_proxy (%s): %s", c.HTTPProxy, err)
+		}
+	}
+
+	if c.HTTPSProxy != "" {
+		httpsProxy, err = url.Parse(c.HTTPSProxy)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing https_proxy (%s): %s", c.HTTPSProxy, err)
+		}
+	}
+
+	noProxy := strings.Split(c.NoProxy, ",")
+
+	return func(req *http.Request) (*url.URL, error) {
+		if proxyBypassed(req.URL.Hostname(), noProxy) {
+			return nil, nil
+		}
+
+		if req.URL.Scheme == "https" {
+			return httpsProxy, nil
+		}
+
+		return httpProxy, nil
+	}, nil
+}
+
+// proxyBypassed returns whether the host matches a no_proxy entry. Entries are
+// host names, which also match their subdomains, IP addresses, CIDR blocks or
+// "*" to match all hosts.
+func proxyBypassed(host string, noProxy []string) bool {
+	host = strings.ToLower(host)
+
+	for _, entry := range noProxy {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+
+		if entry == "" {
+			continue
+		}
+
+		if entry == "*" {
+			return true
+		}
+
+		if _, ipNet, err := net.ParseCIDR(entry); err == nil {
+			if ip := net.ParseIP(host); ip != nil && ipNet.Contains(ip) {
+				return true
+			}
+			continue
+		}
+
+		if h, _, err := net.SplitHostPort(entry); err == nil {
+			entry = h
+		}
+
+		entry = strings.TrimPrefix(entry, ".")
+
+		if host == entry || strings.HasSuffix(host, "."+entry) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fipsEndpointResolver resolves the FIPS 140-2 validated endpoint of a service
+// in the region, as modeled by the "<region>-fips" or "fips-<region>" endpoint
+// names of the SDK endpoints metadata. Services without a FIPS endpoint in the
+// region use their standard endpoint.
+func fipsEndpointResolver(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		if svc, ok := partition.Services()[service]; ok {
+			svcEndpoints := svc.Endpoints()
+
+			for _, fipsRegion := range []string{region + "-fips", "fips-" + region} {
+				endpoint, ok := svcEndpoints[fipsRegion]
+				if !ok {
+					continue
+				}
+
+				resolved, err := endpoint.ResolveEndpoint(opts...)
+				if err != nil {
+					return resolved, err
+				}
+
+				if resolved.SigningRegion == fipsRegion {
+					resolved.SigningRegion = region
+				}
+
+				return resolved, nil
+			}
+		}
+	}
+
+	log.Printf("[WARN] No FIPS endpoint found for %s in %s, using standard endpoint", service, region)
+
+	return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+}
+
+// serviceConfig returns the session configuration overrides for the service
+// client with the given endpoints block name.
+func (c *Config) serviceConfig(name string) *aws.Config {
+	cfg := &aws.Config{
+		Endpoint: aws.String(c.Endpoints[name]),
+	}
+
+	if v, ok := c.ServiceMaxRetries[name]; ok {
+		cfg.MaxRetries = aws.Int(v)
+	}
+
+	return cfg
+}
+
+// stsConfig returns the session configuration overrides for the STS client,
+// which can use a different region than the provider and regional endpoints
+// instead of the global sts.amazonaws.com endpoint.
+func (c *Config) stsConfig() *aws.Config {
+	cfg := c.serviceConfig("sts")
+	region := c.Region
+
+	if c.StsRegion != "" {
+		region = c.StsRegion
+		cfg.Region = aws.String(region)
+	}
+
+	if c.StsRegionalEndpoints == stsRegionalEndpointsRegional && c.Endpoints["sts"] == "" {
+		if endpoint := stsRegionalEndpoint(region); endpoint != "" {
+			cfg.Endpoint = aws.String(endpoint)
+		}
+	}
+
+	return cfg
+}
+
+// stsRegionalEndpoint returns the in-region STS endpoint URL.
+func stsRegionalEndpoint(region string) string {
+	if _, ok := partitionForRegion(region); !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("https://sts.%s.%s", region, partitionDnsSuffix(region))
+}
+
 func hasEc2Classic(platforms []string) bool {
 	for _, p := range platforms {
 		if p == "EC2" {
@@ -638,6 +871,10 @@ func hasEc2Classic(platforms []string) bool {
 // ValidateRegion returns an error if the configured region is not a
 // valid aws region and nil otherwise.
 func (c *Config) ValidateRegion() error {
+	if _, ok := isolatedPartitionForRegion(c.Region); ok {
+		return nil
+	}
+
 	for _, partition := range endpoints.DefaultPartitions() {
 		for _, region := range partition.Regions() {
 			if c.Region == region.ID() {
@@ -725,7 +962,11 @@ var debugAuthFailure = request.NamedHandler{
 	},
 }
 
-type awsLogger struct{}
+// awsLogger writes the AWS SDK log output to the Terraform log at the given
+// level, with any sensitive values redacted.
+type awsLogger struct {
+	level string
+}
 
 func (l awsLogger) Log(args ...interface{}) {
 	tokens := make([]string, 0, len(args))
@@ -734,5 +975,5 @@ func (l awsLogger) Log(args ...interface{}) {
 			tokens = append(tokens, token)
 		}
 	}
-	log.Printf("[DEBUG] [aws-sdk-go] %s", strings.Join(tokens, " "))
+	log.Printf("[%s] [aws-sdk-go] %s", l.level, redactSensitiveValues(strings.Join(tokens, " ")))
 }
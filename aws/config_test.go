@@ -41,6 +41,171 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestProxyBypassed(t *testing.T) {
+	noProxy := []string{"localhost", ".internal.example.com", "example.org:8443", "10.0.0.0/8"}
+
+	cases := []struct {
+		Host     string
+		Expected bool
+	}{
+		{"localhost", true},
+		{"api.internal.example.com", true},
+		{"internal.example.com", true},
+		{"example.com", false},
+		{"sub.example.org", true},
+		{"10.1.2.3", true},
+		{"192.168.1.1", false},
+		{"ec2.us-east-1.amazonaws.com", false},
+	}
+
+	for _, tc := range cases {
+		if actual := proxyBypassed(tc.Host, noProxy); actual != tc.Expected {
+			t.Fatalf("%s: expected %t, got %t", tc.Host, tc.Expected, actual)
+		}
+	}
+
+	if !proxyBypassed("ec2.us-east-1.amazonaws.com", []string{"*"}) {
+		t.Fatalf("expected * to bypass all hosts")
+	}
+}
+
+func TestConfigProxyFunc(t *testing.T) {
+	c := &Config{
+		HTTPProxy:  "http://http-proxy.example.com:3128",
+		HTTPSProxy: "http://https-proxy.example.com:3128",
+		NoProxy:    "169.254.169.254",
+	}
+
+	proxy, err := c.proxyFunc()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		URL, Expected string
+	}{
+		{"https://ec2.us-east-1.amazonaws.com/", "http://https-proxy.example.com:3128"},
+		{"http://s3.amazonaws.com/bucket", "http://http-proxy.example.com:3128"},
+		{"http://169.254.169.254/latest/meta-data/", ""},
+	}
+
+	for _, tc := range cases {
+		req, err := http.NewRequest("GET", tc.URL, nil)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		proxyURL, err := proxy(req)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		var actual string
+		if proxyURL != nil {
+			actual = proxyURL.String()
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("%s: expected proxy %q, got %q", tc.URL, tc.Expected, actual)
+		}
+	}
+}
+
+func TestConfigHTTPClient_caBundle(t *testing.T) {
+	c := &Config{
+		CABundle: "test-fixtures/does-not-exist.pem",
+	}
+
+	if _, err := c.httpClient(); err == nil {
+		t.Fatalf("expected error for missing ca_bundle")
+	}
+}
+
+func TestConfigStsConfig(t *testing.T) {
+	cases := []struct {
+		Config           *Config
+		ExpectedEndpoint string
+		ExpectedRegion   string
+	}{
+		{
+			Config:           &Config{Region: "eu-west-1"},
+			ExpectedEndpoint: "",
+			ExpectedRegion:   "",
+		},
+		{
+			Config:           &Config{Region: "eu-west-1", StsRegionalEndpoints: stsRegionalEndpointsRegional},
+			ExpectedEndpoint: "https://sts.eu-west-1.amazonaws.com",
+			ExpectedRegion:   "",
+		},
+		{
+			Config:           &Config{Region: "eu-west-1", StsRegion: "cn-north-1", StsRegionalEndpoints: stsRegionalEndpointsRegional},
+			ExpectedEndpoint: "https://sts.cn-north-1.amazonaws.com.cn",
+			ExpectedRegion:   "cn-north-1",
+		},
+		{
+			Config: &Config{
+				Region:               "eu-west-1",
+				StsRegionalEndpoints: stsRegionalEndpointsRegional,
+				Endpoints:            map[string]string{"sts": "http://localhost:4566"},
+			},
+			ExpectedEndpoint: "http://localhost:4566",
+			ExpectedRegion:   "",
+		},
+	}
+
+	for i, tc := range cases {
+		cfg := tc.Config.stsConfig()
+
+		if actual := aws.StringValue(cfg.Endpoint); actual != tc.ExpectedEndpoint {
+			t.Fatalf("%d: expected endpoint %q, got %q", i, tc.ExpectedEndpoint, actual)
+		}
+
+		if actual := aws.StringValue(cfg.Region); actual != tc.ExpectedRegion {
+			t.Fatalf("%d: expected region %q, got %q", i, tc.ExpectedRegion, actual)
+		}
+	}
+}
+
+func TestFipsEndpointResolver(t *testing.T) {
+	cases := []struct {
+		Service, Region, ExpectedURL, ExpectedSigningRegion string
+	}{
+		{
+			Service:               "sts",
+			Region:                "us-east-1",
+			ExpectedURL:           "https://sts-fips.us-east-1.amazonaws.com",
+			ExpectedSigningRegion: "us-east-1",
+		},
+		{
+			Service:               "sqs",
+			Region:                "us-west-2",
+			ExpectedURL:           "https://sqs-fips.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: "us-west-2",
+		},
+		{
+			Service:               "sqs",
+			Region:                "eu-west-1",
+			ExpectedURL:           "https://sqs.eu-west-1.amazonaws.com",
+			ExpectedSigningRegion: "eu-west-1",
+		},
+	}
+
+	for _, tc := range cases {
+		resolved, err := fipsEndpointResolver(tc.Service, tc.Region)
+		if err != nil {
+			t.Fatalf("%s (%s): err: %s", tc.Service, tc.Region, err)
+		}
+
+		if resolved.URL != tc.ExpectedURL {
+			t.Fatalf("%s (%s): expected URL %q, got %q", tc.Service, tc.Region, tc.ExpectedURL, resolved.URL)
+		}
+
+		if resolved.SigningRegion != tc.ExpectedSigningRegion {
+			t.Fatalf("%s (%s): expected signing region %q, got %q", tc.Service, tc.Region, tc.ExpectedSigningRegion, resolved.SigningRegion)
+		}
+	}
+}
+
 // getMockedAwsApiSession establishes a httptest server to simulate behaviour
 // of a real AWS API server
 func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(), *session.Session, error) {
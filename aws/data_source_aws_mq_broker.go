@@ -84,6 +84,22 @@ func dataSourceAwsMqBroker() *schema.Resource {
 					},
 				},
 			},
+			"logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audit": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"general": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"maintenance_window_start_time": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsNeptuneOrderableDbInstance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNeptuneOrderableDbInstanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "neptune",
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_class": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"preferred_instance_classes"},
+			},
+			"license_model": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "amazon-license",
+			},
+			"max_iops_per_db_instance": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_iops_per_gib": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"max_storage_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_iops_per_db_instance": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_iops_per_gib": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"min_storage_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"multi_az_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"preferred_instance_classes": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"instance_class"},
+			},
+			"read_replica_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"storage_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"supports_enhanced_monitoring": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"supports_iam_database_authentication": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"supports_iops": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"supports_performance_insights": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"supports_storage_encryption": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"vpc": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsNeptuneOrderableDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	input := &neptune.DescribeOrderableDBInstanceOptionsInput{
+		Engine:       aws.String(d.Get("engine").(string)),
+		LicenseModel: aws.String(d.Get("license_model").(string)),
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_class"); ok {
+		input.DBInstanceClass = aws.String(v.(string))
+	}
+
+	// GetOkExists is required here so that vpc = false is sent to the API
+	if v, ok := d.GetOkExists("vpc"); ok {
+		input.Vpc = aws.Bool(v.(bool))
+	}
+
+	var options []*neptune.OrderableDBInstanceOption
+
+	log.Printf("[DEBUG] Reading Neptune Orderable DB Instance Options: %s", input)
+	err := conn.DescribeOrderableDBInstanceOptionsPages(input, func(page *neptune.DescribeOrderableDBInstanceOptionsOutput, lastPage bool) bool {
+		options = append(options, page.OrderableDBInstanceOptions...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Neptune Orderable DB Instance Options: %s", err)
+	}
+
+	if len(options) == 0 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	var option *neptune.OrderableDBInstanceOption
+
+	if v, ok := d.GetOk("preferred_instance_classes"); ok {
+		// Pick the first preferred class that is orderable, in the order given
+		for _, preferred := range v.([]interface{}) {
+			for _, candidate := range options {
+				if preferred.(string) == aws.StringValue(candidate.DBInstanceClass) {
+					option = candidate
+					break
+				}
+			}
+
+			if option != nil {
+				break
+			}
+		}
+
+		if option == nil {
+			return fmt.Errorf("Your query returned no results for any of the preferred instance classes. Please change your search criteria and try again.")
+		}
+	} else {
+		if len(options) > 1 {
+			return fmt.Errorf("Your query returned more than one result. Please try a more specific search criteria.")
+		}
+
+		option = options[0]
+	}
+
+	d.SetId(resource.UniqueId())
+
+	availabilityZones := make([]string, 0, len(option.AvailabilityZones))
+	for _, az := range option.AvailabilityZones {
+		availabilityZones = append(availabilityZones, aws.StringValue(az.Name))
+	}
+	if err := d.Set("availability_zones", availabilityZones); err != nil {
+		return fmt.Errorf("error setting availability_zones: %s", err)
+	}
+
+	d.Set("engine", option.Engine)
+	d.Set("engine_version", option.EngineVersion)
+	d.Set("instance_class", option.DBInstanceClass)
+	d.Set("license_model", option.LicenseModel)
+	d.Set("max_iops_per_db_instance", option.MaxIopsPerDbInstance)
+	d.Set("max_iops_per_gib", option.MaxIopsPerGib)
+	d.Set("max_storage_size", option.MaxStorageSize)
+	d.Set("min_iops_per_db_instance", option.MinIopsPerDbInstance)
+	d.Set("min_iops_per_gib", option.MinIopsPerGib)
+	d.Set("min_storage_size", option.MinStorageSize)
+	d.Set("multi_az_capable", option.MultiAZCapable)
+	d.Set("read_replica_capable", option.ReadReplicaCapable)
+	d.Set("storage_type", option.StorageType)
+	d.Set("supports_enhanced_monitoring", option.SupportsEnhancedMonitoring)
+	d.Set("supports_iam_database_authentication", option.SupportsIAMDatabaseAuthentication)
+	d.Set("supports_iops", option.SupportsIops)
+	d.Set("supports_performance_insights", option.SupportsPerformanceInsights)
+	d.Set("supports_storage_encryption", option.SupportsStorageEncryption)
+	d.Set("vpc", option.Vpc)
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSNeptuneOrderableDbInstanceDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_neptune_orderable_db_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneOrderableDbInstanceDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "engine", "neptune"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_class", "db.r4.large"),
+					resource.TestCheckResourceAttr(dataSourceName, "license_model", "amazon-license"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "engine_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "availability_zones.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNeptuneOrderableDbInstanceDataSource_preferred(t *testing.T) {
+	dataSourceName := "data.aws_neptune_orderable_db_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneOrderableDbInstanceDataSourceConfig_preferred,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instance_class", "db.r4.xlarge"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSNeptuneOrderableDbInstanceDataSourceConfig_basic = `
+data "aws_neptune_orderable_db_instance" "test" {
+  instance_class = "db.r4.large"
+  engine_version = "1.0.1.0"
+}
+`
+
+const testAccAWSNeptuneOrderableDbInstanceDataSourceConfig_preferred = `
+data "aws_neptune_orderable_db_instance" "test" {
+  engine_version             = "1.0.1.0"
+  preferred_instance_classes = ["db.xyz.xlarge", "db.r4.xlarge", "db.r4.large"]
+}
+`
@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsOrganizationsOrganizationalUnit() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsOrganizationsOrganizationalUnitRead,
+
+		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsOrganizationalUnitRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	path := d.Get("path").(string)
+
+	roots, err := listOrganizationsRoots(conn)
+	if err != nil {
+		return fmt.Errorf("error listing Organization roots: %s", err)
+	}
+
+	if len(roots) == 0 {
+		return fmt.Errorf("no Organization root found")
+	}
+
+	// The path is resolved one organizational unit name at a time, starting from the root
+	parentId := aws.StringValue(roots[0].Id)
+	var organizationalUnit *organizations.OrganizationalUnit
+
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if organizationalUnit != nil {
+			parentId = aws.StringValue(organizationalUnit.Id)
+		}
+
+		log.Printf("[DEBUG] Looking for Organizations Organizational Unit %q in parent: %s", segment, parentId)
+		children, err := listOrganizationsOrganizationalUnitsForParent(conn, parentId)
+		if err != nil {
+			return fmt.Errorf("error listing Organizations Organizational Units for parent (%s): %s", parentId, err)
+		}
+
+		organizationalUnit = nil
+		for _, child := range children {
+			if aws.StringValue(child.Name) == segment {
+				organizationalUnit = child
+				break
+			}
+		}
+
+		if organizationalUnit == nil {
+			return fmt.Errorf("no Organizations Organizational Unit named %q found in parent (%s) for path %q", segment, parentId, path)
+		}
+	}
+
+	id := aws.StringValue(organizationalUnit.Id)
+
+	accounts, err := listOrganizationsAccountsForParent(conn, id)
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Organizational Unit (%s) accounts: %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("arn", organizationalUnit.Arn)
+	d.Set("name", organizationalUnit.Name)
+	d.Set("parent_id", parentId)
+
+	if err := d.Set("accounts", flattenOrganizationsAccounts(accounts)); err != nil {
+		return fmt.Errorf("error setting accounts: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsOrganizationalUnit_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_organizational_unit.child"
+	dataSourceName := "data.aws_organizations_organizational_unit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsOrganizationalUnitConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "parent_id", "aws_organizations_organizational_unit.parent", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsOrganizationalUnitConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "parent" {
+  name      = "%[1]s-parent"
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+resource "aws_organizations_organizational_unit" "child" {
+  name      = "%[1]s-child"
+  parent_id = "${aws_organizations_organizational_unit.parent.id}"
+}
+
+data "aws_organizations_organizational_unit" "test" {
+  path = "${aws_organizations_organizational_unit.parent.name}/${aws_organizations_organizational_unit.child.name}"
+}
+`, rName)
+}
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsOrganizationsOrganizationalUnits() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsOrganizationsOrganizationalUnitsRead,
+
+		Schema: map[string]*schema.Schema{
+			"children": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"parent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAwsOrganizationsParentId,
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsOrganizationalUnitsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	parentId := d.Get("parent_id").(string)
+
+	log.Printf("[DEBUG] Listing Organizations Organizational Units for parent: %s", parentId)
+	children, err := listOrganizationsOrganizationalUnitsForParent(conn, parentId)
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Organizational Units for parent (%s): %s", parentId, err)
+	}
+
+	d.SetId(parentId)
+
+	if err := d.Set("children", flattenOrganizationsOrganizationalUnits(children)); err != nil {
+		return fmt.Errorf("error setting children: %s", err)
+	}
+
+	return nil
+}
+
+func listOrganizationsOrganizationalUnitsForParent(conn *organizations.Organizations, parentId string) ([]*organizations.OrganizationalUnit, error) {
+	var organizationalUnits []*organizations.OrganizationalUnit
+
+	input := &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: aws.String(parentId),
+	}
+
+	err := conn.ListOrganizationalUnitsForParentPages(input, func(page *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+		organizationalUnits = append(organizationalUnits, page.OrganizationalUnits...)
+		return !lastPage
+	})
+
+	return organizationalUnits, err
+}
+
+func flattenOrganizationsOrganizationalUnits(organizationalUnits []*organizations.OrganizationalUnit) []interface{} {
+	result := make([]interface{}, 0, len(organizationalUnits))
+	for _, organizationalUnit := range organizationalUnits {
+		result = append(result, map[string]interface{}{
+			"arn":  aws.StringValue(organizationalUnit.Arn),
+			"id":   aws.StringValue(organizationalUnit.Id),
+			"name": aws.StringValue(organizationalUnit.Name),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsOrganizationalUnits_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_organizational_unit.test"
+	dataSourceName := "data.aws_organizations_organizational_units.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsOrganizationalUnitsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "children.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "children.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "children.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "children.0.name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsOrganizationalUnitsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %q
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+data "aws_organizations_organizational_units" "test" {
+  parent_id = "${aws_organizations_organizational_unit.test.parent_id}"
+}
+`, rName)
+}
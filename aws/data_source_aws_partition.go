@@ -16,6 +16,11 @@ func dataSourceAwsPartition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -28,6 +33,7 @@ func dataSourceAwsPartitionRead(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Setting AWS Partition to %s.", client.partition)
 	d.Set("partition", meta.(*AWSClient).partition)
+	d.Set("dns_suffix", partitionDnsSuffix(client.region))
 
 	return nil
 }
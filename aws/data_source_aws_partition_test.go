@@ -35,6 +35,11 @@ func testAccCheckAwsPartition(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Incorrect Partition: expected %q, got %q", expected, rs.Primary.Attributes["partition"])
 		}
 
+		expectedDnsSuffix := partitionDnsSuffix(testAccProvider.Meta().(*AWSClient).region)
+		if rs.Primary.Attributes["dns_suffix"] != expectedDnsSuffix {
+			return fmt.Errorf("Incorrect DNS Suffix: expected %q, got %q", expectedDnsSuffix, rs.Primary.Attributes["dns_suffix"])
+		}
+
 		return nil
 	}
 }
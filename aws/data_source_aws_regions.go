@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsRegions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_regions": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"filter"},
+			},
+			"filter": dataSourceFiltersSchema(),
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsRegionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	partition := meta.(*AWSClient).partition
+
+	input := &ec2.DescribeRegionsInput{}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filters = buildAwsDataSourceFilters(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Reading Regions: %s", input)
+	output, err := conn.DescribeRegions(input)
+	if err != nil {
+		return fmt.Errorf("error reading Regions: %s", err)
+	}
+
+	names := make(map[string]struct{})
+	for _, region := range output.Regions {
+		names[aws.StringValue(region.RegionName)] = struct{}{}
+	}
+
+	// DescribeRegions only returns the regions enabled for the account, so
+	// include the regions which are not opted in from the SDK metadata.
+	if d.Get("all_regions").(bool) {
+		for _, p := range endpoints.DefaultPartitions() {
+			if p.ID() != partition {
+				continue
+			}
+
+			for name := range p.Regions() {
+				names[name] = struct{}{}
+			}
+		}
+	}
+
+	raw := make([]string, 0, len(names))
+	for name := range names {
+		raw = append(raw, name)
+	}
+
+	sort.Strings(raw)
+
+	d.SetId(partition)
+
+	if err := d.Set("names", raw); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+
+	return nil
+}
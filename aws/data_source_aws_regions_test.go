@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceAwsRegions_basic(t *testing.T) {
+	dataSourceName := "data.aws_regions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRegionsConfig_Empty,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRegionsContainsProviderRegion(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsRegions_AllRegions(t *testing.T) {
+	dataSourceName := "data.aws_regions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRegionsConfig_AllRegions,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRegionsContainsProviderRegion(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsRegions_Filter(t *testing.T) {
+	dataSourceName := "data.aws_regions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRegionsConfig_Filter(testAccGetRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", testAccGetRegion()),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRegionsContainsProviderRegion(dataSourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dataSourceName)
+		}
+
+		region := testAccGetRegion()
+
+		for k, v := range rs.Primary.Attributes {
+			if k != "names.#" && strings.HasPrefix(k, "names.") && v == region {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected region %q in %s names", region, dataSourceName)
+	}
+}
+
+const testAccDataSourceAwsRegionsConfig_Empty = `
+data "aws_regions" "test" {}
+`
+
+const testAccDataSourceAwsRegionsConfig_AllRegions = `
+data "aws_regions" "test" {
+  all_regions = true
+}
+`
+
+func testAccDataSourceAwsRegionsConfig_Filter(region string) string {
+	return fmt.Sprintf(`
+data "aws_regions" "test" {
+  filter {
+    name   = "region-name"
+    values = [%q]
+  }
+}
+`, region)
+}
@@ -70,7 +70,7 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		Resource:  bucket,
 	}.String()
 	d.Set("arn", arn)
-	d.Set("bucket_domain_name", bucketDomainName(bucket))
+	d.Set("bucket_domain_name", bucketDomainName(bucket, meta.(*AWSClient).region))
 
 	if err := bucketLocation(d, bucket, conn); err != nil {
 		return err
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	ec2MetadataTokenOperationName = "GetToken"
+	ec2MetadataTokenHeader        = "X-aws-ec2-metadata-token"
+	ec2MetadataTokenTTLHeader     = "X-aws-ec2-metadata-token-ttl-seconds"
+
+	// Lifetime of the IMDSv2 session token. Tokens are refreshed shortly before
+	// they expire.
+	ec2MetadataTokenTTL           = 6 * time.Hour
+	ec2MetadataTokenRefreshWindow = 1 * time.Minute
+
+	// How long IMDSv1 is used after a token request failed without a
+	// definitive response, e.g. timed out, before a token is requested again.
+	ec2MetadataTokenRetryInterval = 1 * time.Minute
+)
+
+// newEc2MetadataClient returns an EC2 instance metadata service (IMDS) client
+// which uses IMDSv2 session tokens for every request. When a token cannot be
+// retrieved, the client falls back to IMDSv1 requests: permanently when the
+// token endpoint responds that IMDSv2 is not available, otherwise, for example
+// when the PUT response is dropped because it would exceed the instance
+// metadata hop limit, until the token request is retried.
+func newEc2MetadataClient(cfg *aws.Config) *ec2metadata.EC2Metadata {
+	metadataClient := ec2metadata.New(session.New(cfg))
+
+	tokenProvider := &ec2MetadataTokenProvider{client: metadataClient}
+
+	metadataClient.Handlers.Build.PushBackNamed(request.NamedHandler{
+		Name: "terraform.EC2MetadataTokenHandler",
+		Fn:   tokenProvider.addTokenHeader,
+	})
+
+	return metadataClient
+}
+
+// ec2MetadataTokenProvider retrieves and caches the IMDSv2 session token.
+type ec2MetadataTokenProvider struct {
+	client *ec2metadata.EC2Metadata
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	retryAt   time.Time
+	disableV2 bool
+}
+
+func (p *ec2MetadataTokenProvider) addTokenHeader(r *request.Request) {
+	if r.Operation.Name == ec2MetadataTokenOperationName {
+		return
+	}
+
+	if token := p.getToken(); token != "" {
+		r.HTTPRequest.Header.Set(ec2MetadataTokenHeader, token)
+	}
+}
+
+func (p *ec2MetadataTokenProvider) getToken() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.disableV2 {
+		return ""
+	}
+
+	now := time.Now()
+
+	if p.token != "" && now.Before(p.expiresAt) {
+		return p.token
+	}
+
+	if now.Before(p.retryAt) {
+		return ""
+	}
+
+	token, ttl, statusCode, err := p.fetchToken()
+	if err != nil {
+		switch statusCode {
+		case http.StatusForbidden, http.StatusNotFound, http.StatusMethodNotAllowed:
+			log.Printf("[INFO] EC2 metadata session tokens not available, using IMDSv1: %s", err)
+			p.disableV2 = true
+		default:
+			// A missing response is typical for a metadata hop limit which is
+			// too low, e.g. inside containers. Don't wait for every later
+			// request, but try again later.
+			log.Printf("[INFO] Unable to retrieve EC2 metadata session token, using IMDSv1 for %s: %s", ec2MetadataTokenRetryInterval, err)
+			p.retryAt = now.Add(ec2MetadataTokenRetryInterval)
+		}
+
+		return ""
+	}
+
+	p.token = token
+	p.expiresAt = time.Now().Add(ttl - ec2MetadataTokenRefreshWindow)
+
+	return p.token
+}
+
+// fetchToken requests a session token. The HTTP status code of the response is
+// returned, or 0 when no response was received.
+func (p *ec2MetadataTokenProvider) fetchToken() (string, time.Duration, int, error) {
+	op := &request.Operation{
+		Name:       ec2MetadataTokenOperationName,
+		HTTPMethod: http.MethodPut,
+		HTTPPath:   "/api/token",
+	}
+
+	var token string
+	req := p.client.NewRequest(op, nil, nil)
+	// Retry once, so an unreachable token endpoint still falls back quickly.
+	req.Retryer = client.DefaultRetryer{NumMaxRetries: 1}
+	req.HTTPRequest.Header.Set(ec2MetadataTokenTTLHeader, strconv.Itoa(int(ec2MetadataTokenTTL/time.Second)))
+	req.Handlers.Unmarshal.Clear()
+	req.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		defer r.HTTPResponse.Body.Close()
+
+		b := &bytes.Buffer{}
+		if _, err := io.Copy(b, r.HTTPResponse.Body); err != nil {
+			r.Error = awserr.New("SerializationError", "unable to unmarshal EC2 metadata session token", err)
+			return
+		}
+
+		token = b.String()
+	})
+
+	if err := req.Send(); err != nil {
+		statusCode := 0
+		if req.HTTPResponse != nil {
+			statusCode = req.HTTPResponse.StatusCode
+		}
+
+		return "", 0, statusCode, err
+	}
+
+	ttl := ec2MetadataTokenTTL
+	if v, err := strconv.Atoi(req.HTTPResponse.Header.Get(ec2MetadataTokenTTLHeader)); err == nil && v > 0 {
+		ttl = time.Duration(v) * time.Second
+	}
+
+	return token, ttl, req.HTTPResponse.StatusCode, nil
+}
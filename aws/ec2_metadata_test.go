@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestEc2MetadataClient_token(t *testing.T) {
+	tokenRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/latest/api/token":
+			if r.Header.Get(ec2MetadataTokenTTLHeader) == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			tokenRequests++
+			w.Header().Set(ec2MetadataTokenTTLHeader, r.Header.Get(ec2MetadataTokenTTLHeader))
+			fmt.Fprint(w, "test-token")
+		case r.Method == http.MethodGet && r.URL.Path == "/latest/meta-data/instance-id":
+			if r.Header.Get(ec2MetadataTokenHeader) != "test-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, "i-12345678")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := newEc2MetadataClient(&aws.Config{Endpoint: aws.String(ts.URL + "/latest")})
+
+	for i := 0; i < 2; i++ {
+		instanceID, err := client.GetMetadata("instance-id")
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if instanceID != "i-12345678" {
+			t.Fatalf("expected instance ID i-12345678, got: %s", instanceID)
+		}
+	}
+
+	if tokenRequests != 1 {
+		t.Fatalf("expected the session token to be requested once, got: %d", tokenRequests)
+	}
+}
+
+func TestEc2MetadataClient_fallbackToIMDSv1(t *testing.T) {
+	tokenRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut:
+			tokenRequests++
+			w.WriteHeader(http.StatusForbidden)
+		case r.URL.Path == "/latest/meta-data/instance-id":
+			if r.Header.Get(ec2MetadataTokenHeader) != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, "i-12345678")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := newEc2MetadataClient(&aws.Config{Endpoint: aws.String(ts.URL + "/latest")})
+
+	for i := 0; i < 2; i++ {
+		instanceID, err := client.GetMetadata("instance-id")
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if instanceID != "i-12345678" {
+			t.Fatalf("expected instance ID i-12345678, got: %s", instanceID)
+		}
+	}
+
+	if tokenRequests != 1 {
+		t.Fatalf("expected the session token to be requested once, got: %d", tokenRequests)
+	}
+}
+
+func TestEc2MetadataClient_tokenRetry(t *testing.T) {
+	tokenRequests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/latest/api/token":
+			tokenRequests++
+			if tokenRequests == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			fmt.Fprint(w, "test-token")
+		case r.Method == http.MethodGet && r.URL.Path == "/latest/meta-data/instance-id":
+			if r.Header.Get(ec2MetadataTokenHeader) != "test-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, "i-12345678")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := newEc2MetadataClient(&aws.Config{Endpoint: aws.String(ts.URL + "/latest")})
+
+	instanceID, err := client.GetMetadata("instance-id")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if instanceID != "i-12345678" {
+		t.Fatalf("expected instance ID i-12345678, got: %s", instanceID)
+	}
+
+	if tokenRequests != 2 {
+		t.Fatalf("expected the session token request to be retried once, got: %d requests", tokenRequests)
+	}
+}
+
+func TestEc2MetadataTokenProvider_retryAfterFailure(t *testing.T) {
+	var available int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/latest/api/token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if atomic.LoadInt32(&available) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		fmt.Fprint(w, "test-token")
+	}))
+	defer ts.Close()
+
+	p := &ec2MetadataTokenProvider{
+		client: ec2metadata.New(session.New(&aws.Config{Endpoint: aws.String(ts.URL + "/latest")})),
+	}
+
+	if token := p.getToken(); token != "" {
+		t.Fatalf("expected no token, got: %s", token)
+	}
+
+	if p.disableV2 {
+		t.Fatalf("expected IMDSv2 not to be disabled after a failed token request")
+	}
+
+	if !p.retryAt.After(time.Now()) {
+		t.Fatalf("expected the token request to be retried later, got: %s", p.retryAt)
+	}
+
+	atomic.StoreInt32(&available, 1)
+
+	// Within the retry interval, IMDSv1 is used without requesting a token.
+	if token := p.getToken(); token != "" {
+		t.Fatalf("expected no token within the retry interval, got: %s", token)
+	}
+
+	p.retryAt = time.Now().Add(-time.Second)
+
+	if token := p.getToken(); token != "test-token" {
+		t.Fatalf("expected token after the retry interval, got: %q", token)
+	}
+}
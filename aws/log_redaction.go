@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"regexp"
+)
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveNamePattern matches the names of headers, parameters, JSON keys and
+// XML elements whose values must never be written to the log, such as
+// SecretAccessKey, MasterUserPassword, SessionToken, PrivateKey and the KMS
+// Plaintext.
+const sensitiveNamePattern = `[\w.-]*(?i:password|secret|token|privatekey|private_key|plaintext|keymaterial|key_material)[\w.-]*`
+
+var (
+	// Authorization: AWS4-HMAC-SHA256 Credential=...
+	sensitiveHeaderRegexp = regexp.MustCompile(`(?mi)^((?:Authorization|` + sensitiveNamePattern + `):\s*).*$`)
+
+	// "SecretAccessKey": "..."
+	sensitiveJSONRegexp = regexp.MustCompile(`("` + sensitiveNamePattern + `"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// <SecretAccessKey>...</SecretAccessKey>
+	sensitiveXMLRegexp = regexp.MustCompile(`(<` + sensitiveNamePattern + `>)[^<]*(</)`)
+
+	// Action=CreateDBInstance&MasterUserPassword=...
+	sensitiveQueryRegexp = regexp.MustCompile(`((?:^|[?&\s])` + sensitiveNamePattern + `=)[^&\s]*`)
+)
+
+// redactSensitiveValues replaces the values of credentials, passwords, tokens
+// and key material in logged AWS API requests and responses.
+func redactSensitiveValues(s string) string {
+	s = sensitiveHeaderRegexp.ReplaceAllString(s, "${1}"+redactedValue)
+	s = sensitiveJSONRegexp.ReplaceAllString(s, `${1}"`+redactedValue+`"`)
+	s = sensitiveXMLRegexp.ReplaceAllString(s, "${1}"+redactedValue+"${2}")
+	s = sensitiveQueryRegexp.ReplaceAllString(s, "${1}"+redactedValue)
+
+	return s
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestRedactSensitiveValues(t *testing.T) {
+	cases := []struct {
+		Input, Expected string
+	}{
+		// Headers
+		{
+			Input:    "POST / HTTP/1.1\nAuthorization: AWS4-HMAC-SHA256 Credential=AKIAEXAMPLE/20181010/us-east-1/sts/aws4_request\nX-Amz-Security-Token: FQoGZXIvYXdzEXAMPLE\nContent-Type: application/x-amz-json-1.1",
+			Expected: "POST / HTTP/1.1\nAuthorization: [REDACTED]\nX-Amz-Security-Token: [REDACTED]\nContent-Type: application/x-amz-json-1.1",
+		},
+		// JSON
+		{
+			Input:    `{"Name":"example","SecretString":"hunter2","Description":"a \"quoted\" value"}`,
+			Expected: `{"Name":"example","SecretString":"[REDACTED]","Description":"a \"quoted\" value"}`,
+		},
+		{
+			Input:    `{"Plaintext": "c2VjcmV0\"", "KeyId": "alias/example"}`,
+			Expected: `{"Plaintext": "[REDACTED]", "KeyId": "alias/example"}`,
+		},
+		// XML
+		{
+			Input:    "<Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SecretAccessKey>wJalrXUtnFEMI</SecretAccessKey><SessionToken>FQoGZXIvYXdz</SessionToken></Credentials>",
+			Expected: "<Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SecretAccessKey>[REDACTED]</SecretAccessKey><SessionToken>[REDACTED]</SessionToken></Credentials>",
+		},
+		// Query
+		{
+			Input:    "Action=CreateDBInstance&DBInstanceIdentifier=example&MasterUserPassword=hunter2&Version=2014-10-31",
+			Expected: "Action=CreateDBInstance&DBInstanceIdentifier=example&MasterUserPassword=[REDACTED]&Version=2014-10-31",
+		},
+		// Nothing sensitive
+		{
+			Input:    `{"VpcId":"vpc-12345678","CidrBlock":"10.0.0.0/16"}`,
+			Expected: `{"VpcId":"vpc-12345678","CidrBlock":"10.0.0.0/16"}`,
+		},
+	}
+
+	for i, tc := range cases {
+		actual := redactSensitiveValues(tc.Input)
+		if actual != tc.Expected {
+			t.Fatalf("%d: expected:\n%s\n\ngot:\n%s", i, tc.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// isolatedPartition is an AWS partition which is not modeled in the endpoints
+// metadata of the AWS SDK, such as the C2S and SC2S isolated regions.
+type isolatedPartition struct {
+	id          string
+	dnsSuffix   string
+	regionRegex *regexp.Regexp
+}
+
+var isolatedPartitions = []isolatedPartition{
+	{
+		id:          "aws-iso",
+		dnsSuffix:   "c2s.ic.gov",
+		regionRegex: regexp.MustCompile(`^us\-iso\-\w+\-\d+$`),
+	},
+	{
+		id:          "aws-iso-b",
+		dnsSuffix:   "sc2s.sgov.gov",
+		regionRegex: regexp.MustCompile(`^us\-isob\-\w+\-\d+$`),
+	},
+}
+
+func isolatedPartitionForRegion(region string) (isolatedPartition, bool) {
+	for _, p := range isolatedPartitions {
+		if p.regionRegex.MatchString(region) {
+			return p, true
+		}
+	}
+
+	return isolatedPartition{}, false
+}
+
+// partitionForRegion returns the ID of the partition the region belongs to,
+// including the isolated partitions unknown to the AWS SDK.
+func partitionForRegion(region string) (string, bool) {
+	if p, ok := isolatedPartitionForRegion(region); ok {
+		return p.id, true
+	}
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		return p.ID(), true
+	}
+
+	return "", false
+}
+
+// partitionDnsSuffix returns the DNS suffix of the service endpoints in the
+// region's partition, e.g. amazonaws.com or amazonaws.com.cn.
+func partitionDnsSuffix(region string) string {
+	if p, ok := isolatedPartitionForRegion(region); ok {
+		return p.dnsSuffix
+	}
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() == endpoints.AwsCnPartitionID {
+		return "amazonaws.com.cn"
+	}
+
+	return "amazonaws.com"
+}
+
+// isolatedPartitionEndpointResolver resolves the standard service endpoint
+// hostnames in the isolated partitions and otherwise uses the SDK resolver.
+func isolatedPartitionEndpointResolver(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	p, ok := isolatedPartitionForRegion(region)
+	if !ok {
+		return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+	}
+
+	return endpoints.ResolvedEndpoint{
+		URL:           fmt.Sprintf("https://%s.%s.%s", service, region, p.dnsSuffix),
+		SigningRegion: region,
+		SigningMethod: "v4",
+	}, nil
+}
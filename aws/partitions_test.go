@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestPartitionForRegion(t *testing.T) {
+	cases := []struct {
+		Region            string
+		ExpectedPartition string
+		ExpectedDnsSuffix string
+		ExpectedOk        bool
+	}{
+		{"us-east-1", "aws", "amazonaws.com", true},
+		{"cn-north-1", "aws-cn", "amazonaws.com.cn", true},
+		{"us-gov-west-1", "aws-us-gov", "amazonaws.com", true},
+		{"us-iso-east-1", "aws-iso", "c2s.ic.gov", true},
+		{"us-isob-east-1", "aws-iso-b", "sc2s.sgov.gov", true},
+		{"non-existent-1", "", "amazonaws.com", false},
+	}
+
+	for _, tc := range cases {
+		partition, ok := partitionForRegion(tc.Region)
+		if ok != tc.ExpectedOk || partition != tc.ExpectedPartition {
+			t.Fatalf("%s: expected partition %q (%t), got %q (%t)", tc.Region, tc.ExpectedPartition, tc.ExpectedOk, partition, ok)
+		}
+
+		if dnsSuffix := partitionDnsSuffix(tc.Region); dnsSuffix != tc.ExpectedDnsSuffix {
+			t.Fatalf("%s: expected DNS suffix %q, got %q", tc.Region, tc.ExpectedDnsSuffix, dnsSuffix)
+		}
+	}
+}
+
+func TestIsolatedPartitionEndpointResolver(t *testing.T) {
+	cases := []struct {
+		Service, Region, ExpectedURL string
+	}{
+		{"ec2", "us-iso-east-1", "https://ec2.us-iso-east-1.c2s.ic.gov"},
+		{"sts", "us-isob-east-1", "https://sts.us-isob-east-1.sc2s.sgov.gov"},
+		{"ec2", "us-west-2", "https://ec2.us-west-2.amazonaws.com"},
+	}
+
+	for _, tc := range cases {
+		endpoint, err := isolatedPartitionEndpointResolver(tc.Service, tc.Region)
+		if err != nil {
+			t.Fatalf("%s %s: err: %s", tc.Service, tc.Region, err)
+		}
+
+		if endpoint.URL != tc.ExpectedURL {
+			t.Fatalf("%s %s: expected %q, got %q", tc.Service, tc.Region, tc.ExpectedURL, endpoint.URL)
+		}
+
+		if endpoint.SigningRegion != tc.Region {
+			t.Fatalf("%s %s: expected signing region %q, got %q", tc.Service, tc.Region, tc.Region, endpoint.SigningRegion)
+		}
+	}
+}
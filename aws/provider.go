@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -18,7 +19,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -75,6 +76,21 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      retryModeStandard,
+				Description:  descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice([]string{retryModeAdaptive, retryModeStandard}, false),
+			},
+
+			"service_max_retries": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: descriptions["service_max_retries"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -107,8 +123,26 @@ func Provider() terraform.ResourceProvider {
 				Removed:     "Use `kinesis` inside `endpoints` block instead",
 			},
 
+			"default_tags": defaultTagsSchema(),
+
 			"endpoints": endpointsSchema(),
 
+			"ignore_tags": ignoreTagsSchema(),
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -116,6 +150,51 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["insecure"],
 			},
 
+			"sts_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["sts_region"],
+			},
+
+			"sts_regional_endpoints": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      stsRegionalEndpointsLegacy,
+				Description:  descriptions["sts_regional_endpoints"],
+				ValidateFunc: validation.StringInSlice([]string{stsRegionalEndpointsLegacy, stsRegionalEndpointsRegional}, false),
+			},
+
+			"ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["ca_bundle"],
+			},
+
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["http_proxy"],
+			},
+
+			"https_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["https_proxy"],
+			},
+
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["no_proxy"],
+			},
+
+			"log_http_bodies": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["log_http_bodies"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -144,6 +223,13 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["skip_requesting_account_id"],
 			},
 
+			"skip_caller_identity_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["skip_caller_identity_cache"],
+			},
+
 			"skip_metadata_api_check": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -160,110 +246,115 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                  dataSourceAwsAcmCertificate(),
-			"aws_acmpca_certificate_authority":     dataSourceAwsAcmpcaCertificateAuthority(),
-			"aws_ami":                              dataSourceAwsAmi(),
-			"aws_ami_ids":                          dataSourceAwsAmiIds(),
-			"aws_api_gateway_resource":             dataSourceAwsApiGatewayResource(),
-			"aws_api_gateway_rest_api":             dataSourceAwsApiGatewayRestApi(),
-			"aws_arn":                              dataSourceAwsArn(),
-			"aws_autoscaling_groups":               dataSourceAwsAutoscalingGroups(),
-			"aws_availability_zone":                dataSourceAwsAvailabilityZone(),
-			"aws_availability_zones":               dataSourceAwsAvailabilityZones(),
-			"aws_batch_compute_environment":        dataSourceAwsBatchComputeEnvironment(),
-			"aws_batch_job_queue":                  dataSourceAwsBatchJobQueue(),
-			"aws_billing_service_account":          dataSourceAwsBillingServiceAccount(),
-			"aws_caller_identity":                  dataSourceAwsCallerIdentity(),
-			"aws_canonical_user_id":                dataSourceAwsCanonicalUserId(),
-			"aws_cloudformation_export":            dataSourceAwsCloudFormationExport(),
-			"aws_cloudformation_stack":             dataSourceAwsCloudFormationStack(),
-			"aws_cloudtrail_service_account":       dataSourceAwsCloudTrailServiceAccount(),
-			"aws_cloudwatch_log_group":             dataSourceAwsCloudwatchLogGroup(),
-			"aws_cognito_user_pools":               dataSourceAwsCognitoUserPools(),
-			"aws_codecommit_repository":            dataSourceAwsCodeCommitRepository(),
-			"aws_db_cluster_snapshot":              dataSourceAwsDbClusterSnapshot(),
-			"aws_db_instance":                      dataSourceAwsDbInstance(),
-			"aws_db_snapshot":                      dataSourceAwsDbSnapshot(),
-			"aws_dx_gateway":                       dataSourceAwsDxGateway(),
-			"aws_dynamodb_table":                   dataSourceAwsDynamoDbTable(),
-			"aws_ebs_snapshot":                     dataSourceAwsEbsSnapshot(),
-			"aws_ebs_snapshot_ids":                 dataSourceAwsEbsSnapshotIds(),
-			"aws_ebs_volume":                       dataSourceAwsEbsVolume(),
-			"aws_ecr_repository":                   dataSourceAwsEcrRepository(),
-			"aws_ecs_cluster":                      dataSourceAwsEcsCluster(),
-			"aws_ecs_container_definition":         dataSourceAwsEcsContainerDefinition(),
-			"aws_ecs_service":                      dataSourceAwsEcsService(),
-			"aws_ecs_task_definition":              dataSourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":                  dataSourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":                 dataSourceAwsEfsMountTarget(),
-			"aws_eip":                              dataSourceAwsEip(),
-			"aws_eks_cluster":                      dataSourceAwsEksCluster(),
-			"aws_elastic_beanstalk_hosted_zone":    dataSourceAwsElasticBeanstalkHostedZone(),
-			"aws_elastic_beanstalk_solution_stack": dataSourceAwsElasticBeanstalkSolutionStack(),
-			"aws_elasticache_cluster":              dataSourceAwsElastiCacheCluster(),
-			"aws_elb":                              dataSourceAwsElb(),
-			"aws_elasticache_replication_group":    dataSourceAwsElasticacheReplicationGroup(),
-			"aws_elb_hosted_zone_id":               dataSourceAwsElbHostedZoneId(),
-			"aws_elb_service_account":              dataSourceAwsElbServiceAccount(),
-			"aws_glue_script":                      dataSourceAwsGlueScript(),
-			"aws_iam_account_alias":                dataSourceAwsIamAccountAlias(),
-			"aws_iam_group":                        dataSourceAwsIAMGroup(),
-			"aws_iam_instance_profile":             dataSourceAwsIAMInstanceProfile(),
-			"aws_iam_policy":                       dataSourceAwsIAMPolicy(),
-			"aws_iam_policy_document":              dataSourceAwsIamPolicyDocument(),
-			"aws_iam_role":                         dataSourceAwsIAMRole(),
-			"aws_iam_server_certificate":           dataSourceAwsIAMServerCertificate(),
-			"aws_iam_user":                         dataSourceAwsIAMUser(),
-			"aws_internet_gateway":                 dataSourceAwsInternetGateway(),
-			"aws_iot_endpoint":                     dataSourceAwsIotEndpoint(),
-			"aws_inspector_rules_packages":         dataSourceAwsInspectorRulesPackages(),
-			"aws_instance":                         dataSourceAwsInstance(),
-			"aws_instances":                        dataSourceAwsInstances(),
-			"aws_ip_ranges":                        dataSourceAwsIPRanges(),
-			"aws_kinesis_stream":                   dataSourceAwsKinesisStream(),
-			"aws_kms_alias":                        dataSourceAwsKmsAlias(),
-			"aws_kms_ciphertext":                   dataSourceAwsKmsCiphertext(),
-			"aws_kms_key":                          dataSourceAwsKmsKey(),
-			"aws_kms_secret":                       dataSourceAwsKmsSecret(),
-			"aws_kms_secrets":                      dataSourceAwsKmsSecrets(),
-			"aws_lambda_function":                  dataSourceAwsLambdaFunction(),
-			"aws_lambda_invocation":                dataSourceAwsLambdaInvocation(),
-			"aws_launch_configuration":             dataSourceAwsLaunchConfiguration(),
-			"aws_mq_broker":                        dataSourceAwsMqBroker(),
-			"aws_nat_gateway":                      dataSourceAwsNatGateway(),
-			"aws_network_acls":                     dataSourceAwsNetworkAcls(),
-			"aws_network_interface":                dataSourceAwsNetworkInterface(),
-			"aws_network_interfaces":               dataSourceAwsNetworkInterfaces(),
-			"aws_partition":                        dataSourceAwsPartition(),
-			"aws_prefix_list":                      dataSourceAwsPrefixList(),
-			"aws_pricing_product":                  dataSourceAwsPricingProduct(),
-			"aws_rds_cluster":                      dataSourceAwsRdsCluster(),
-			"aws_redshift_cluster":                 dataSourceAwsRedshiftCluster(),
-			"aws_redshift_service_account":         dataSourceAwsRedshiftServiceAccount(),
-			"aws_region":                           dataSourceAwsRegion(),
-			"aws_route":                            dataSourceAwsRoute(),
-			"aws_route_table":                      dataSourceAwsRouteTable(),
-			"aws_route_tables":                     dataSourceAwsRouteTables(),
-			"aws_route53_zone":                     dataSourceAwsRoute53Zone(),
-			"aws_s3_bucket":                        dataSourceAwsS3Bucket(),
-			"aws_s3_bucket_object":                 dataSourceAwsS3BucketObject(),
-			"aws_secretsmanager_secret":            dataSourceAwsSecretsManagerSecret(),
-			"aws_secretsmanager_secret_version":    dataSourceAwsSecretsManagerSecretVersion(),
-			"aws_sns_topic":                        dataSourceAwsSnsTopic(),
-			"aws_sqs_queue":                        dataSourceAwsSqsQueue(),
-			"aws_ssm_parameter":                    dataSourceAwsSsmParameter(),
-			"aws_storagegateway_local_disk":        dataSourceAwsStorageGatewayLocalDisk(),
-			"aws_subnet":                           dataSourceAwsSubnet(),
-			"aws_subnet_ids":                       dataSourceAwsSubnetIDs(),
-			"aws_vpcs":                             dataSourceAwsVpcs(),
-			"aws_security_group":                   dataSourceAwsSecurityGroup(),
-			"aws_security_groups":                  dataSourceAwsSecurityGroups(),
-			"aws_vpc":                              dataSourceAwsVpc(),
-			"aws_vpc_dhcp_options":                 dataSourceAwsVpcDhcpOptions(),
-			"aws_vpc_endpoint":                     dataSourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_service":             dataSourceAwsVpcEndpointService(),
-			"aws_vpc_peering_connection":           dataSourceAwsVpcPeeringConnection(),
-			"aws_vpn_gateway":                      dataSourceAwsVpnGateway(),
+			"aws_acm_certificate":                    dataSourceAwsAcmCertificate(),
+			"aws_acmpca_certificate_authority":       dataSourceAwsAcmpcaCertificateAuthority(),
+			"aws_ami":                                dataSourceAwsAmi(),
+			"aws_ami_ids":                            dataSourceAwsAmiIds(),
+			"aws_api_gateway_resource":               dataSourceAwsApiGatewayResource(),
+			"aws_api_gateway_rest_api":               dataSourceAwsApiGatewayRestApi(),
+			"aws_arn":                                dataSourceAwsArn(),
+			"aws_autoscaling_groups":                 dataSourceAwsAutoscalingGroups(),
+			"aws_availability_zone":                  dataSourceAwsAvailabilityZone(),
+			"aws_availability_zones":                 dataSourceAwsAvailabilityZones(),
+			"aws_batch_compute_environment":          dataSourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_queue":                    dataSourceAwsBatchJobQueue(),
+			"aws_billing_service_account":            dataSourceAwsBillingServiceAccount(),
+			"aws_caller_identity":                    dataSourceAwsCallerIdentity(),
+			"aws_canonical_user_id":                  dataSourceAwsCanonicalUserId(),
+			"aws_cloudformation_export":              dataSourceAwsCloudFormationExport(),
+			"aws_cloudformation_stack":               dataSourceAwsCloudFormationStack(),
+			"aws_cloudtrail_service_account":         dataSourceAwsCloudTrailServiceAccount(),
+			"aws_cloudwatch_log_group":               dataSourceAwsCloudwatchLogGroup(),
+			"aws_cognito_user_pools":                 dataSourceAwsCognitoUserPools(),
+			"aws_codecommit_repository":              dataSourceAwsCodeCommitRepository(),
+			"aws_db_cluster_snapshot":                dataSourceAwsDbClusterSnapshot(),
+			"aws_db_instance":                        dataSourceAwsDbInstance(),
+			"aws_db_snapshot":                        dataSourceAwsDbSnapshot(),
+			"aws_dx_gateway":                         dataSourceAwsDxGateway(),
+			"aws_dynamodb_table":                     dataSourceAwsDynamoDbTable(),
+			"aws_ebs_snapshot":                       dataSourceAwsEbsSnapshot(),
+			"aws_ebs_snapshot_ids":                   dataSourceAwsEbsSnapshotIds(),
+			"aws_ebs_volume":                         dataSourceAwsEbsVolume(),
+			"aws_ecr_repository":                     dataSourceAwsEcrRepository(),
+			"aws_ecs_cluster":                        dataSourceAwsEcsCluster(),
+			"aws_ecs_container_definition":           dataSourceAwsEcsContainerDefinition(),
+			"aws_ecs_service":                        dataSourceAwsEcsService(),
+			"aws_ecs_task_definition":                dataSourceAwsEcsTaskDefinition(),
+			"aws_efs_file_system":                    dataSourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                   dataSourceAwsEfsMountTarget(),
+			"aws_eip":                                dataSourceAwsEip(),
+			"aws_eks_cluster":                        dataSourceAwsEksCluster(),
+			"aws_elastic_beanstalk_hosted_zone":      dataSourceAwsElasticBeanstalkHostedZone(),
+			"aws_elastic_beanstalk_solution_stack":   dataSourceAwsElasticBeanstalkSolutionStack(),
+			"aws_elasticache_cluster":                dataSourceAwsElastiCacheCluster(),
+			"aws_elb":                                dataSourceAwsElb(),
+			"aws_elasticache_replication_group":      dataSourceAwsElasticacheReplicationGroup(),
+			"aws_elb_hosted_zone_id":                 dataSourceAwsElbHostedZoneId(),
+			"aws_elb_service_account":                dataSourceAwsElbServiceAccount(),
+			"aws_glue_script":                        dataSourceAwsGlueScript(),
+			"aws_iam_account_alias":                  dataSourceAwsIamAccountAlias(),
+			"aws_iam_group":                          dataSourceAwsIAMGroup(),
+			"aws_iam_instance_profile":               dataSourceAwsIAMInstanceProfile(),
+			"aws_iam_policy":                         dataSourceAwsIAMPolicy(),
+			"aws_iam_policy_document":                dataSourceAwsIamPolicyDocument(),
+			"aws_iam_role":                           dataSourceAwsIAMRole(),
+			"aws_iam_server_certificate":             dataSourceAwsIAMServerCertificate(),
+			"aws_iam_user":                           dataSourceAwsIAMUser(),
+			"aws_internet_gateway":                   dataSourceAwsInternetGateway(),
+			"aws_iot_endpoint":                       dataSourceAwsIotEndpoint(),
+			"aws_inspector_rules_packages":           dataSourceAwsInspectorRulesPackages(),
+			"aws_instance":                           dataSourceAwsInstance(),
+			"aws_instances":                          dataSourceAwsInstances(),
+			"aws_ip_ranges":                          dataSourceAwsIPRanges(),
+			"aws_kinesis_stream":                     dataSourceAwsKinesisStream(),
+			"aws_kms_alias":                          dataSourceAwsKmsAlias(),
+			"aws_kms_ciphertext":                     dataSourceAwsKmsCiphertext(),
+			"aws_kms_key":                            dataSourceAwsKmsKey(),
+			"aws_kms_secret":                         dataSourceAwsKmsSecret(),
+			"aws_kms_secrets":                        dataSourceAwsKmsSecrets(),
+			"aws_lambda_function":                    dataSourceAwsLambdaFunction(),
+			"aws_lambda_invocation":                  dataSourceAwsLambdaInvocation(),
+			"aws_launch_configuration":               dataSourceAwsLaunchConfiguration(),
+			"aws_launch_template":                    dataSourceAwsLaunchTemplate(),
+			"aws_mq_broker":                          dataSourceAwsMqBroker(),
+			"aws_nat_gateway":                        dataSourceAwsNatGateway(),
+			"aws_neptune_orderable_db_instance":      dataSourceAwsNeptuneOrderableDbInstance(),
+			"aws_network_acls":                       dataSourceAwsNetworkAcls(),
+			"aws_network_interface":                  dataSourceAwsNetworkInterface(),
+			"aws_network_interfaces":                 dataSourceAwsNetworkInterfaces(),
+			"aws_organizations_organizational_unit":  dataSourceAwsOrganizationsOrganizationalUnit(),
+			"aws_organizations_organizational_units": dataSourceAwsOrganizationsOrganizationalUnits(),
+			"aws_partition":                          dataSourceAwsPartition(),
+			"aws_prefix_list":                        dataSourceAwsPrefixList(),
+			"aws_pricing_product":                    dataSourceAwsPricingProduct(),
+			"aws_rds_cluster":                        dataSourceAwsRdsCluster(),
+			"aws_redshift_cluster":                   dataSourceAwsRedshiftCluster(),
+			"aws_redshift_service_account":           dataSourceAwsRedshiftServiceAccount(),
+			"aws_region":                             dataSourceAwsRegion(),
+			"aws_regions":                            dataSourceAwsRegions(),
+			"aws_route":                              dataSourceAwsRoute(),
+			"aws_route_table":                        dataSourceAwsRouteTable(),
+			"aws_route_tables":                       dataSourceAwsRouteTables(),
+			"aws_route53_zone":                       dataSourceAwsRoute53Zone(),
+			"aws_s3_bucket":                          dataSourceAwsS3Bucket(),
+			"aws_s3_bucket_object":                   dataSourceAwsS3BucketObject(),
+			"aws_secretsmanager_secret":              dataSourceAwsSecretsManagerSecret(),
+			"aws_secretsmanager_secret_version":      dataSourceAwsSecretsManagerSecretVersion(),
+			"aws_sns_topic":                          dataSourceAwsSnsTopic(),
+			"aws_sqs_queue":                          dataSourceAwsSqsQueue(),
+			"aws_ssm_parameter":                      dataSourceAwsSsmParameter(),
+			"aws_storagegateway_local_disk":          dataSourceAwsStorageGatewayLocalDisk(),
+			"aws_subnet":                             dataSourceAwsSubnet(),
+			"aws_subnet_ids":                         dataSourceAwsSubnetIDs(),
+			"aws_vpcs":                               dataSourceAwsVpcs(),
+			"aws_security_group":                     dataSourceAwsSecurityGroup(),
+			"aws_security_groups":                    dataSourceAwsSecurityGroups(),
+			"aws_vpc":                                dataSourceAwsVpc(),
+			"aws_vpc_dhcp_options":                   dataSourceAwsVpcDhcpOptions(),
+			"aws_vpc_endpoint":                       dataSourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_service":               dataSourceAwsVpcEndpointService(),
+			"aws_vpc_peering_connection":             dataSourceAwsVpcPeeringConnection(),
+			"aws_vpn_gateway":                        dataSourceAwsVpnGateway(),
 
 			// Adding the Aliases for the ALB -> LB Rename
 			"aws_lb":               dataSourceAwsLb(),
@@ -275,9 +366,10 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                              resourceAwsAcmCertificate(),
-			"aws_acm_certificate_validation":                   resourceAwsAcmCertificateValidation(),
-			"aws_acmpca_certificate_authority":                 resourceAwsAcmpcaCertificateAuthority(),
+			"aws_acm_certificate":                          resourceAwsAcmCertificate(),
+			"aws_acm_certificate_validation":               resourceAwsAcmCertificateValidation(),
+			"aws_acmpca_certificate_authority":             resourceAwsAcmpcaCertificateAuthority(),
+			"aws_acmpca_certificate_authority_certificate": resourceAwsAcmpcaCertificateAuthorityCertificate(),
 			"aws_ami":                                          resourceAwsAmi(),
 			"aws_ami_copy":                                     resourceAwsAmiCopy(),
 			"aws_ami_from_instance":                            resourceAwsAmiFromInstance(),
@@ -324,7 +416,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_cloud9_environment_ec2":                       resourceAwsCloud9EnvironmentEc2(),
 			"aws_cloudformation_stack":                         resourceAwsCloudFormationStack(),
 			"aws_cloudfront_distribution":                      resourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_field_level_encryption_config":     resourceAwsCloudFrontFieldLevelEncryptionConfig(),
+			"aws_cloudfront_field_level_encryption_profile":    resourceAwsCloudFrontFieldLevelEncryptionProfile(),
 			"aws_cloudfront_origin_access_identity":            resourceAwsCloudFrontOriginAccessIdentity(),
+			"aws_cloudfront_public_key":                        resourceAwsCloudFrontPublicKey(),
 			"aws_cloudtrail":                                   resourceAwsCloudTrail(),
 			"aws_cloudwatch_event_permission":                  resourceAwsCloudWatchEventPermission(),
 			"aws_cloudwatch_event_rule":                        resourceAwsCloudWatchEventRule(),
@@ -350,6 +445,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_cognito_user_pool_client":                     resourceAwsCognitoUserPoolClient(),
 			"aws_cognito_user_pool_domain":                     resourceAwsCognitoUserPoolDomain(),
 			"aws_cognito_resource_server":                      resourceAwsCognitoResourceServer(),
+			"aws_cur_report_definition":                        resourceAwsCurReportDefinition(),
 			"aws_cloudwatch_metric_alarm":                      resourceAwsCloudWatchMetricAlarm(),
 			"aws_cloudwatch_dashboard":                         resourceAwsCloudWatchDashboard(),
 			"aws_codedeploy_app":                               resourceAwsCodeDeployApp(),
@@ -424,9 +520,11 @@ func Provider() terraform.ResourceProvider {
 			"aws_elb":                                          resourceAwsElb(),
 			"aws_elb_attachment":                               resourceAwsElbAttachment(),
 			"aws_emr_cluster":                                  resourceAwsEMRCluster(),
+			"aws_emr_instance_fleet":                           resourceAwsEMRInstanceFleet(),
 			"aws_emr_instance_group":                           resourceAwsEMRInstanceGroup(),
 			"aws_emr_security_configuration":                   resourceAwsEMRSecurityConfiguration(),
 			"aws_flow_log":                                     resourceAwsFlowLog(),
+			"aws_fms_policy":                                   resourceAwsFmsPolicy(),
 			"aws_gamelift_alias":                               resourceAwsGameliftAlias(),
 			"aws_gamelift_build":                               resourceAwsGameliftBuild(),
 			"aws_gamelift_fleet":                               resourceAwsGameliftFleet(),
@@ -439,6 +537,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_glue_job":                                     resourceAwsGlueJob(),
 			"aws_glue_trigger":                                 resourceAwsGlueTrigger(),
 			"aws_guardduty_detector":                           resourceAwsGuardDutyDetector(),
+			"aws_guardduty_filter":                             resourceAwsGuardDutyFilter(),
 			"aws_guardduty_ipset":                              resourceAwsGuardDutyIpset(),
 			"aws_guardduty_member":                             resourceAwsGuardDutyMember(),
 			"aws_guardduty_threatintelset":                     resourceAwsGuardDutyThreatintelset(),
@@ -479,6 +578,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_kinesis_firehose_delivery_stream":             resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                               resourceAwsKinesisStream(),
 			"aws_kms_alias":                                    resourceAwsKmsAlias(),
+			"aws_kms_external_key":                             resourceAwsKmsExternalKey(),
 			"aws_kms_grant":                                    resourceAwsKmsGrant(),
 			"aws_kms_key":                                      resourceAwsKmsKey(),
 			"aws_lambda_function":                              resourceAwsLambdaFunction(),
@@ -535,6 +635,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_opsworks_rds_db_instance":                     resourceAwsOpsworksRdsDbInstance(),
 			"aws_organizations_organization":                   resourceAwsOrganizationsOrganization(),
 			"aws_organizations_account":                        resourceAwsOrganizationsAccount(),
+			"aws_organizations_organizational_unit":            resourceAwsOrganizationsOrganizationalUnit(),
 			"aws_organizations_policy":                         resourceAwsOrganizationsPolicy(),
 			"aws_organizations_policy_attachment":              resourceAwsOrganizationsPolicyAttachment(),
 			"aws_placement_group":                              resourceAwsPlacementGroup(),
@@ -546,6 +647,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_redshift_security_group":                      resourceAwsRedshiftSecurityGroup(),
 			"aws_redshift_parameter_group":                     resourceAwsRedshiftParameterGroup(),
 			"aws_redshift_subnet_group":                        resourceAwsRedshiftSubnetGroup(),
+			"aws_resourcegroups_group":                         resourceAwsResourceGroupsGroup(),
 			"aws_route53_delegation_set":                       resourceAwsRoute53DelegationSet(),
 			"aws_route53_query_log":                            resourceAwsRoute53QueryLog(),
 			"aws_route53_record":                               resourceAwsRoute53Record(),
@@ -557,6 +659,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_default_route_table":                          resourceAwsDefaultRouteTable(),
 			"aws_route_table_association":                      resourceAwsRouteTableAssociation(),
 			"aws_secretsmanager_secret":                        resourceAwsSecretsManagerSecret(),
+			"aws_secretsmanager_secret_rotation":               resourceAwsSecretsManagerSecretRotation(),
 			"aws_secretsmanager_secret_version":                resourceAwsSecretsManagerSecretVersion(),
 			"aws_ses_active_receipt_rule_set":                  resourceAwsSesActiveReceiptRuleSet(),
 			"aws_ses_domain_identity":                          resourceAwsSesDomainIdentity(),
@@ -580,7 +683,12 @@ func Provider() terraform.ResourceProvider {
 			"aws_network_interface_sg_attachment":              resourceAwsNetworkInterfaceSGAttachment(),
 			"aws_default_security_group":                       resourceAwsDefaultSecurityGroup(),
 			"aws_security_group_rule":                          resourceAwsSecurityGroupRule(),
+			"aws_servicecatalog_constraint":                    resourceAwsServiceCatalogConstraint(),
 			"aws_servicecatalog_portfolio":                     resourceAwsServiceCatalogPortfolio(),
+			"aws_servicecatalog_portfolio_share":               resourceAwsServiceCatalogPortfolioShare(),
+			"aws_servicecatalog_product":                       resourceAwsServiceCatalogProduct(),
+			"aws_servicecatalog_product_portfolio_association": resourceAwsServiceCatalogProductPortfolioAssociation(),
+			"aws_service_discovery_instance":                   resourceAwsServiceDiscoveryInstance(),
 			"aws_service_discovery_private_dns_namespace":      resourceAwsServiceDiscoveryPrivateDnsNamespace(),
 			"aws_service_discovery_public_dns_namespace":       resourceAwsServiceDiscoveryPublicDnsNamespace(),
 			"aws_service_discovery_service":                    resourceAwsServiceDiscoveryService(),
@@ -664,6 +772,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_wafregional_xss_match_set":                    resourceAwsWafRegionalXssMatchSet(),
 			"aws_wafregional_web_acl":                          resourceAwsWafRegionalWebAcl(),
 			"aws_wafregional_web_acl_association":              resourceAwsWafRegionalWebAclAssociation(),
+			"aws_workspaces_ip_group":                          resourceAwsWorkspacesIpGroup(),
+			"aws_workspaces_workspace":                         resourceAwsWorkspacesWorkspace(),
+			"aws_xray_sampling_rule":                           resourceAwsXraySamplingRule(),
 			"aws_batch_compute_environment":                    resourceAwsBatchComputeEnvironment(),
 			"aws_batch_job_definition":                         resourceAwsBatchJobDefinition(),
 			"aws_batch_job_queue":                              resourceAwsBatchJobQueue(),
@@ -687,8 +798,19 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, r := range provider.ResourcesMap {
+		wrapResourceProviderTags(name, r)
+	}
+
+	return provider
 }
 
+const (
+	stsRegionalEndpointsLegacy   = "legacy"
+	stsRegionalEndpointsRegional = "regional"
+)
+
 var descriptions map[string]string
 
 func init() {
@@ -715,17 +837,20 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
-		"apigateway_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard` and\n" +
+			"`adaptive`. The `adaptive` mode additionally limits the request rate to a\n" +
+			"service after it responds with throttling errors.",
 
-		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"service_max_retries": "Map of endpoints block service names to the maximum number\n" +
+			"of times an API request to that service is retried, overriding `max_retries`.",
 
-		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"endpoint": "Use this to override the default service endpoint URL",
 
-		"cloudwatchevents_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability, supporting both\n" +
+			"IPv4 and IPv6, for services which provide one.",
 
-		"cloudwatchlogs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"devicefarm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"use_fips_endpoint": "Resolve an endpoint with FIPS 140-2 validated cryptographic modules,\n" +
+			"for services which provide one in the region.",
 
 		"dynamodb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to dynamodb-local.",
@@ -733,34 +858,26 @@ func init() {
 		"kinesis_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to kinesalite.",
 
-		"kms_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"iam_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"lambda_endpoint": "Use this to override the default endpoint URL constructed from the `region`\n",
-
-		"ec2_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"autoscaling_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"efs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"elb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
+			"default value is `false`",
 
-		"es_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"sts_region": "The region for STS API calls, including AssumeRole. Defaults to the provider region.",
 
-		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"sts_regional_endpoints": "Whether STS API calls use the global endpoint (`legacy`) or\n" +
+			"the endpoint in the STS region (`regional`).",
 
-		"s3_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"ca_bundle": "Path to a file of PEM encoded certificate authorities used to verify\n" +
+			"the TLS certificates of AWS API endpoints, e.g. of a TLS intercepting proxy.",
 
-		"sns_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"http_proxy": "URL of the proxy for HTTP requests. Overrides the HTTP_PROXY environment variable.",
 
-		"sqs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"https_proxy": "URL of the proxy for HTTPS requests. Overrides the HTTPS_PROXY environment variable.",
 
-		"ssm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"no_proxy": "Comma-separated list of hosts which bypass the proxy.\n" +
+			"Overrides the NO_PROXY environment variable.",
 
-		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
-			"default value is `false`",
+		"log_http_bodies": "Log the AWS API request and response bodies when TF_LOG is set to TRACE.\n" +
+			"Credentials, passwords, tokens and key material are redacted.",
 
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
@@ -774,6 +891,9 @@ func init() {
 		"skip_requesting_account_id": "Skip requesting the account ID. " +
 			"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 
+		"skip_caller_identity_cache": "Skip the cached account ID and partition of provider " +
+			"configurations using the same credentials and look them up again.",
+
 		"skip_medatadata_api_check": "Skip the AWS Metadata API check. " +
 			"Used for AWS API implementations that do not have a metadata api endpoint.",
 
@@ -782,6 +902,17 @@ func init() {
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 
+		"default_tags": "Configuration block with settings to default resource tags across all resources.",
+
+		"default_tags_tags": "Resource tags to default across all resources. Tags configured" +
+			" on a resource override the default value of the same key.",
+
+		"ignore_tags": "Configuration block with settings to ignore resource tags across all resources.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
+
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
@@ -793,6 +924,9 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session. If omitted," +
+			" the STS default of one hour is used.",
 	}
 }
 
@@ -804,13 +938,25 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Token:                   d.Get("token").(string),
 		Region:                  d.Get("region").(string),
 		MaxRetries:              d.Get("max_retries").(int),
+		RetryMode:               d.Get("retry_mode").(string),
 		Insecure:                d.Get("insecure").(bool),
+		StsRegion:               d.Get("sts_region").(string),
+		StsRegionalEndpoints:    d.Get("sts_regional_endpoints").(string),
+		CABundle:                d.Get("ca_bundle").(string),
+		HTTPProxy:               d.Get("http_proxy").(string),
+		HTTPSProxy:              d.Get("https_proxy").(string),
+		NoProxy:                 d.Get("no_proxy").(string),
+		LogHTTPBodies:           d.Get("log_http_bodies").(bool),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
+		SkipCallerIdentityCache: d.Get("skip_caller_identity_cache").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
+		Endpoints:               make(map[string]string),
+		UseDualStackEndpoint:    d.Get("use_dualstack_endpoint").(bool),
+		UseFipsEndpoint:         d.Get("use_fips_endpoint").(bool),
 	}
 
 	// Set CredsFilename, expanding home directory
@@ -831,42 +977,51 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			config.AssumeRolePolicy = v
 		}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
+		if v, ok := assumeRole["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleDurationSeconds = v
+		}
+
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, DurationSeconds: %d)",
+			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy, config.AssumeRoleDurationSeconds)
 	} else {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	if l := d.Get("default_tags").([]interface{}); len(l) > 0 && l[0] != nil {
+		config.DefaultTags = l[0].(map[string]interface{})["tags"].(map[string]interface{})
+	}
+
+	if l := d.Get("ignore_tags").([]interface{}); len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["keys"].(*schema.Set); ok {
+			config.IgnoreTagsKeys = expandStringSet(v)
+		}
+
+		if v, ok := m["key_prefixes"].(*schema.Set); ok {
+			config.IgnoreTagsKeyPrefixes = expandStringSet(v)
+		}
+	}
+
+	if v, ok := d.GetOk("service_max_retries"); ok {
+		config.ServiceMaxRetries = make(map[string]int)
+
+		for name, maxRetries := range v.(map[string]interface{}) {
+			if !isEndpointServiceName(name) {
+				return nil, fmt.Errorf("service_max_retries: unsupported service name %q", name)
+			}
+
+			config.ServiceMaxRetries[name] = maxRetries.(int)
+		}
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.AcmEndpoint = endpoints["acm"].(string)
-		config.ApigatewayEndpoint = endpoints["apigateway"].(string)
-		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
-		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
-		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
-		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
-		config.DeviceFarmEndpoint = endpoints["devicefarm"].(string)
-		config.DynamoDBEndpoint = endpoints["dynamodb"].(string)
-		config.Ec2Endpoint = endpoints["ec2"].(string)
-		config.AutoscalingEndpoint = endpoints["autoscaling"].(string)
-		config.EcrEndpoint = endpoints["ecr"].(string)
-		config.EcsEndpoint = endpoints["ecs"].(string)
-		config.EfsEndpoint = endpoints["efs"].(string)
-		config.ElbEndpoint = endpoints["elb"].(string)
-		config.EsEndpoint = endpoints["es"].(string)
-		config.IamEndpoint = endpoints["iam"].(string)
-		config.KinesisEndpoint = endpoints["kinesis"].(string)
-		config.KmsEndpoint = endpoints["kms"].(string)
-		config.LambdaEndpoint = endpoints["lambda"].(string)
-		config.R53Endpoint = endpoints["r53"].(string)
-		config.RdsEndpoint = endpoints["rds"].(string)
-		config.S3Endpoint = endpoints["s3"].(string)
-		config.SnsEndpoint = endpoints["sns"].(string)
-		config.SqsEndpoint = endpoints["sqs"].(string)
-		config.StsEndpoint = endpoints["sts"].(string)
-		config.SsmEndpoint = endpoints["ssm"].(string)
+		for _, endpointServiceName := range endpointServiceNames {
+			config.Endpoints[endpointServiceName] = endpoints[endpointServiceName].(string)
+		}
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -913,180 +1068,178 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_duration_seconds"],
+				},
 			},
 		},
 	}
 }
 
-func endpointsSchema() *schema.Schema {
+func defaultTagsSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"acm": {
-					Type:        schema.TypeString,
+				"tags": {
+					Type:        schema.TypeMap,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["acm_endpoint"],
-				},
-				"apigateway": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["apigateway_endpoint"],
-				},
-				"cloudwatch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatch_endpoint"],
-				},
-				"cloudwatchevents": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchevents_endpoint"],
-				},
-				"cloudwatchlogs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchlogs_endpoint"],
-				},
-				"cloudformation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudformation_endpoint"],
-				},
-				"devicefarm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["devicefarm_endpoint"],
-				},
-				"dynamodb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dynamodb_endpoint"],
-				},
-				"iam": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
+					Description: descriptions["default_tags_tags"],
 				},
+			},
+		},
+	}
+}
 
-				"ec2": {
-					Type:        schema.TypeString,
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ec2_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
 				},
-
-				"autoscaling": {
-					Type:        schema.TypeString,
+				"key_prefixes": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["autoscaling_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
 				},
+			},
+		},
+	}
+}
 
-				"ecr": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecr_endpoint"],
-				},
+// endpointServiceNames are the services whose endpoint can be customized in
+// the provider endpoints block.
+var endpointServiceNames = []string{
+	"acm",
+	"acmpca",
+	"apigateway",
+	"applicationautoscaling",
+	"appsync",
+	"athena",
+	"autoscaling",
+	"batch",
+	"budgets",
+	"cloud9",
+	"cloudformation",
+	"cloudfront",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codebuild",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"cognitoidentity",
+	"cognitoidp",
+	"configservice",
+	"cur",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"dms",
+	"ds",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecs",
+	"efs",
+	"eks",
+	"elasticache",
+	"elasticbeanstalk",
+	"elastictranscoder",
+	"elb",
+	"emr",
+	"es",
+	"firehose",
+	"fms",
+	"gamelift",
+	"glacier",
+	"glue",
+	"guardduty",
+	"iam",
+	"inspector",
+	"iot",
+	"kinesis",
+	"kms",
+	"lambda",
+	"lexmodels",
+	"lightsail",
+	"macie",
+	"mediastore",
+	"mq",
+	"neptune",
+	"opsworks",
+	"organizations",
+	"pricing",
+	"r53",
+	"rds",
+	"redshift",
+	"resourcegroups",
+	"s3",
+	"sdb",
+	"secretsmanager",
+	"servicecatalog",
+	"servicediscovery",
+	"ses",
+	"sfn",
+	"sns",
+	"sqs",
+	"ssm",
+	"storagegateway",
+	"sts",
+	"swf",
+	"waf",
+	"wafregional",
+	"workspaces",
+	"xray",
+}
 
-				"ecs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecs_endpoint"],
-				},
+func isEndpointServiceName(name string) bool {
+	for _, endpointServiceName := range endpointServiceNames {
+		if name == endpointServiceName {
+			return true
+		}
+	}
 
-				"efs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["efs_endpoint"],
-				},
+	return false
+}
 
-				"elb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["elb_endpoint"],
-				},
-				"es": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["es_endpoint"],
-				},
-				"kinesis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_endpoint"],
-				},
-				"kms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kms_endpoint"],
-				},
-				"lambda": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["lambda_endpoint"],
-				},
-				"r53": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["r53_endpoint"],
-				},
-				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
-				},
-				"s3": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["s3_endpoint"],
-				},
-				"sns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sns_endpoint"],
-				},
-				"sqs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sqs_endpoint"],
-				},
-				"sts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sts_endpoint"],
-				},
-				"ssm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ssm_endpoint"],
-				},
-			},
+func endpointsSchema() *schema.Schema {
+	endpointsAttributes := make(map[string]*schema.Schema)
+
+	for _, endpointServiceName := range endpointServiceNames {
+		endpointsAttributes[endpointServiceName] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: descriptions["endpoint"],
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: endpointsAttributes,
 		},
 		Set: endpointsToHash,
 	}
@@ -1095,25 +1248,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["apigateway"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["devicefarm"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["autoscaling"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["efs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["lambda"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	for _, endpointServiceName := range endpointServiceNames {
+		buf.WriteString(fmt.Sprintf("%s-", m[endpointServiceName].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
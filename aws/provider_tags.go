@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// wrapResourceProviderTags adds provider level tag handling to a resource
+// with a top-level "tags" map. Tags matching the provider ignore_tags are
+// removed from state after every read. For resources that support in-place
+// updates of their tags, the computed "tags_all" attribute additionally holds
+// the resource tags merged with the provider default_tags and is what the
+// tagging helpers send to the AWS APIs. Resources whose tags are required,
+// force a new resource or cannot be updated do not support default_tags.
+func wrapResourceProviderTags(name string, r *schema.Resource) {
+	tags, ok := r.Schema["tags"]
+	if !ok || tags.Type != schema.TypeMap {
+		return
+	}
+
+	if _, ok := r.Schema["tags_all"]; ok {
+		return
+	}
+
+	if tags.Required || tags.ForceNew || r.Update == nil {
+		var warnOnce sync.Once
+
+		read := r.Read
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if err := read(d, meta); err != nil {
+				return err
+			}
+
+			if len(providerDefaultTags(meta)) > 0 {
+				warnOnce.Do(func() {
+					log.Printf("[WARN] %s does not support updating its tags in place, provider default_tags are not applied", name)
+				})
+			}
+
+			return setProviderIgnoredTags(d, meta)
+		}
+
+		return
+	}
+
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(diff, meta); err != nil {
+				return err
+			}
+		}
+
+		return setTagsAllDiff(diff, meta)
+	}
+
+	// The configured tags are captured before the resource functions set
+	// "tags" to the tags read from AWS. During refresh, the tags in state are
+	// the configured tags of the last apply.
+	create := r.Create
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		configuredTags := d.Get("tags").(map[string]interface{})
+
+		if err := create(d, meta); err != nil {
+			return err
+		}
+
+		return setProviderTags(d, meta, configuredTags)
+	}
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		configuredTags := d.Get("tags").(map[string]interface{})
+
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		return setProviderTags(d, meta, configuredTags)
+	}
+
+	update := r.Update
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		configuredTags := d.Get("tags").(map[string]interface{})
+
+		if err := update(d, meta); err != nil {
+			return err
+		}
+
+		return setProviderTags(d, meta, configuredTags)
+	}
+}
+
+// setTagsAllDiff plans "tags_all" as the provider default_tags overridden by
+// the resource tags, without any provider ignored tags.
+func setTagsAllDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	tags := diff.Get("tags").(map[string]interface{})
+	tagsAll := mergeProviderDefaultTags(providerDefaultTags(meta), tags)
+
+	return diff.SetNew("tags_all", removeProviderIgnoredTags(meta, tagsAll))
+}
+
+// setProviderTags splits the tags read from AWS into the full set of tags,
+// including provider default_tags, and the resource tags. A default tag
+// removed or changed outside of Terraform therefore shows as a difference of
+// "tags_all".
+func setProviderTags(d *schema.ResourceData, meta interface{}, configuredTags map[string]interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	tagsAll := removeProviderIgnoredTags(meta, d.Get("tags").(map[string]interface{}))
+
+	if err := d.Set("tags_all", tagsAll); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
+	tags := removeProviderDefaultTags(providerDefaultTags(meta), configuredTags, tagsAll)
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// setProviderIgnoredTags removes the provider ignored tags from the tags read
+// from AWS.
+func setProviderIgnoredTags(d *schema.ResourceData, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	if err := d.Set("tags", removeProviderIgnoredTags(meta, tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func providerDefaultTags(meta interface{}) map[string]interface{} {
+	client, ok := meta.(*AWSClient)
+	if !ok || client == nil {
+		return nil
+	}
+
+	return client.defaultTags
+}
+
+// mergeProviderDefaultTags returns the default tags with the resource tags
+// applied on top, so a resource can override a default tag value.
+func mergeProviderDefaultTags(defaultTags, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// removeProviderDefaultTags returns the tags which are not provided by the
+// default tags. A tag with the same key but a different value than the
+// default is a resource override and is kept, as is a tag configured on the
+// resource with the same value as the default.
+func removeProviderDefaultTags(defaultTags, configuredTags, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+
+	for k, v := range tags {
+		if _, ok := configuredTags[k]; !ok {
+			if dv, ok := defaultTags[k]; ok && dv == v {
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// removeProviderIgnoredTags returns the tags whose keys do not match the
+// provider ignore_tags keys or key prefixes.
+func removeProviderIgnoredTags(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	client, ok := meta.(*AWSClient)
+	if !ok || client == nil || (len(client.ignoreTagsKeys) == 0 && len(client.ignoreTagsKeyPrefixes) == 0) {
+		return tags
+	}
+
+	result := make(map[string]interface{}, len(tags))
+
+	for k, v := range tags {
+		if providerTagIgnored(client, k) {
+			log.Printf("[DEBUG] Ignoring tag %q matching provider ignore_tags", k)
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+func providerTagIgnored(client *AWSClient, key string) bool {
+	for _, ignoreKey := range client.ignoreTagsKeys {
+		if key == aws.StringValue(ignoreKey) {
+			return true
+		}
+	}
+
+	for _, ignoreKeyPrefix := range client.ignoreTagsKeyPrefixes {
+		if strings.HasPrefix(key, aws.StringValue(ignoreKeyPrefix)) {
+			return true
+		}
+	}
+
+	return false
+}
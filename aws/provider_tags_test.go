@@ -0,0 +1,360 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMergeProviderDefaultTags(t *testing.T) {
+	cases := []struct {
+		DefaultTags, Tags, Expected map[string]interface{}
+	}{
+		// No default tags
+		{
+			DefaultTags: nil,
+			Tags: map[string]interface{}{
+				"Name": "test",
+			},
+			Expected: map[string]interface{}{
+				"Name": "test",
+			},
+		},
+
+		// Default tags only
+		{
+			DefaultTags: map[string]interface{}{
+				"CostCenter": "1234",
+			},
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"CostCenter": "1234",
+			},
+		},
+
+		// Resource tag overrides default tag
+		{
+			DefaultTags: map[string]interface{}{
+				"CostCenter": "1234",
+				"Owner":      "platform",
+			},
+			Tags: map[string]interface{}{
+				"Name":  "test",
+				"Owner": "team",
+			},
+			Expected: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "test",
+				"Owner":      "team",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := mergeProviderDefaultTags(tc.DefaultTags, tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestRemoveProviderDefaultTags(t *testing.T) {
+	cases := []struct {
+		DefaultTags, ConfiguredTags, Tags, Expected map[string]interface{}
+	}{
+		// No default tags
+		{
+			DefaultTags: nil,
+			Tags: map[string]interface{}{
+				"Name": "test",
+			},
+			Expected: map[string]interface{}{
+				"Name": "test",
+			},
+		},
+
+		// Default tag removed
+		{
+			DefaultTags: map[string]interface{}{
+				"CostCenter": "1234",
+			},
+			Tags: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "test",
+			},
+			Expected: map[string]interface{}{
+				"Name": "test",
+			},
+		},
+
+		// Overridden default tag kept
+		{
+			DefaultTags: map[string]interface{}{
+				"Owner": "platform",
+			},
+			Tags: map[string]interface{}{
+				"Owner": "team",
+			},
+			Expected: map[string]interface{}{
+				"Owner": "team",
+			},
+		},
+
+		// Configured tag with the default value kept
+		{
+			DefaultTags: map[string]interface{}{
+				"CostCenter": "1234",
+				"Owner":      "platform",
+			},
+			ConfiguredTags: map[string]interface{}{
+				"Owner": "platform",
+			},
+			Tags: map[string]interface{}{
+				"CostCenter": "1234",
+				"Owner":      "platform",
+			},
+			Expected: map[string]interface{}{
+				"Owner": "platform",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := removeProviderDefaultTags(tc.DefaultTags, tc.ConfiguredTags, tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestRemoveProviderIgnoredTags(t *testing.T) {
+	cases := []struct {
+		Client         *AWSClient
+		Tags, Expected map[string]interface{}
+	}{
+		// No ignore tags configuration
+		{
+			Client: &AWSClient{},
+			Tags: map[string]interface{}{
+				"Name": "test",
+			},
+			Expected: map[string]interface{}{
+				"Name": "test",
+			},
+		},
+
+		// Ignored key
+		{
+			Client: &AWSClient{
+				ignoreTagsKeys: aws.StringSlice([]string{"CreatedBy"}),
+			},
+			Tags: map[string]interface{}{
+				"CreatedBy": "backup",
+				"Name":      "test",
+			},
+			Expected: map[string]interface{}{
+				"Name": "test",
+			},
+		},
+
+		// Ignored key prefix
+		{
+			Client: &AWSClient{
+				ignoreTagsKeyPrefixes: aws.StringSlice([]string{"kubernetes.io/"}),
+			},
+			Tags: map[string]interface{}{
+				"kubernetes.io/cluster/test": "owned",
+				"kubernetes":                 "test",
+			},
+			Expected: map[string]interface{}{
+				"kubernetes": "test",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := removeProviderIgnoredTags(tc.Client, tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestWrapResourceProviderTags(t *testing.T) {
+	cases := []struct {
+		// Tags in state before the refresh, i.e. the configured tags
+		StateTags map[string]interface{}
+		// Tags returned by AWS
+		AwsTags         map[string]interface{}
+		ExpectedTags    map[string]interface{}
+		ExpectedTagsAll map[string]interface{}
+	}{
+		// Default and ignored tags removed from tags
+		{
+			StateTags: map[string]interface{}{
+				"Name": "test",
+			},
+			AwsTags: map[string]interface{}{
+				"CostCenter": "1234",
+				"CreatedBy":  "backup",
+				"Name":       "test",
+			},
+			ExpectedTags: map[string]interface{}{
+				"Name": "test",
+			},
+			ExpectedTagsAll: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "test",
+			},
+		},
+
+		// Default tag removed outside of Terraform
+		{
+			StateTags: map[string]interface{}{
+				"Name": "test",
+			},
+			AwsTags: map[string]interface{}{
+				"Name": "test",
+			},
+			ExpectedTags: map[string]interface{}{
+				"Name": "test",
+			},
+			ExpectedTagsAll: map[string]interface{}{
+				"Name": "test",
+			},
+		},
+
+		// Default tag changed outside of Terraform
+		{
+			StateTags: map[string]interface{}{
+				"Name": "test",
+			},
+			AwsTags: map[string]interface{}{
+				"CostCenter": "5678",
+				"Name":       "test",
+			},
+			ExpectedTags: map[string]interface{}{
+				"CostCenter": "5678",
+				"Name":       "test",
+			},
+			ExpectedTagsAll: map[string]interface{}{
+				"CostCenter": "5678",
+				"Name":       "test",
+			},
+		},
+
+		// Resource tag configured with the default value
+		{
+			StateTags: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "test",
+			},
+			AwsTags: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "test",
+			},
+			ExpectedTags: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "test",
+			},
+			ExpectedTagsAll: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "test",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		awsTags := tc.AwsTags
+
+		r := &schema.Resource{
+			Create: func(d *schema.ResourceData, meta interface{}) error { return nil },
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				return d.Set("tags", awsTags)
+			},
+			Update: func(d *schema.ResourceData, meta interface{}) error { return nil },
+			Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+
+			Schema: map[string]*schema.Schema{
+				"tags": tagsSchema(),
+			},
+		}
+
+		wrapResourceProviderTags("aws_test", r)
+
+		if _, ok := r.Schema["tags_all"]; !ok {
+			t.Fatalf("expected tags_all to be added to the resource schema")
+		}
+
+		if err := r.InternalValidate(nil, true); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		client := &AWSClient{
+			defaultTags: map[string]interface{}{
+				"CostCenter": "1234",
+			},
+			ignoreTagsKeys: aws.StringSlice([]string{"CreatedBy"}),
+		}
+
+		d := r.TestResourceData()
+		d.SetId("test")
+		d.Set("tags", tc.StateTags)
+
+		if err := r.Read(d, client); err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, tc.ExpectedTags) {
+			t.Fatalf("%d: bad tags: %#v", i, actual)
+		}
+
+		if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, tc.ExpectedTagsAll) {
+			t.Fatalf("%d: bad tags_all: %#v", i, actual)
+		}
+	}
+}
+
+func TestWrapResourceProviderTags_forceNew(t *testing.T) {
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error { return nil },
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+
+	wrapResourceProviderTags("aws_test", r)
+
+	if _, ok := r.Schema["tags_all"]; ok {
+		t.Fatalf("expected tags_all not to be added to the resource schema")
+	}
+
+	client := &AWSClient{
+		ignoreTagsKeyPrefixes: aws.StringSlice([]string{"aws-backup:"}),
+	}
+
+	d := r.TestResourceData()
+	d.SetId("test")
+	d.Set("tags", map[string]interface{}{
+		"aws-backup:plan": "daily",
+		"Name":            "test",
+	})
+
+	if err := r.Read(d, client); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expectedTags := map[string]interface{}{
+		"Name": "test",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("bad tags: %#v", actual)
+	}
+}
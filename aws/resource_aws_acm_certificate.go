@@ -34,9 +34,18 @@ func resourceAwsAcmCertificate() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"validation_method": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"certificate_authority_arn"},
+			},
+			"certificate_authority_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateArn,
+				ConflictsWith: []string{"validation_method"},
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -79,8 +88,15 @@ func resourceAwsAcmCertificate() *schema.Resource {
 func resourceAwsAcmCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	acmconn := meta.(*AWSClient).acmconn
 	params := &acm.RequestCertificateInput{
-		DomainName:       aws.String(d.Get("domain_name").(string)),
-		ValidationMethod: aws.String(d.Get("validation_method").(string)),
+		DomainName: aws.String(d.Get("domain_name").(string)),
+	}
+
+	if v, ok := d.GetOk("certificate_authority_arn"); ok {
+		params.CertificateAuthorityArn = aws.String(v.(string))
+	} else if v, ok := d.GetOk("validation_method"); ok {
+		params.ValidationMethod = aws.String(v.(string))
+	} else {
+		return fmt.Errorf("one of validation_method or certificate_authority_arn must be configured")
 	}
 
 	sans, ok := d.GetOk("subject_alternative_names")
@@ -97,7 +113,7 @@ func resourceAwsAcmCertificateCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(*resp.CertificateArn)
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params := &acm.AddTagsToCertificateInput{
 			CertificateArn: resp.CertificateArn,
 			Tags:           tagsFromMapACM(v.(map[string]interface{})),
@@ -132,6 +148,7 @@ func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) err
 
 		d.Set("domain_name", resp.Certificate.DomainName)
 		d.Set("arn", resp.Certificate.CertificateArn)
+		d.Set("certificate_authority_arn", resp.Certificate.CertificateAuthorityArn)
 
 		if err := d.Set("subject_alternative_names", cleanUpSubjectAlternativeNames(resp.Certificate)); err != nil {
 			return resource.NonRetryableError(err)
@@ -176,7 +193,7 @@ func resourceAwsAcmCertificateGuessValidationMethod(domainValidationOptions []ma
 }
 
 func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		acmconn := meta.(*AWSClient).acmconn
 		err := setTagsACM(acmconn, d)
 		if err != nil {
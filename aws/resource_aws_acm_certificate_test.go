@@ -331,6 +331,35 @@ func TestAccAWSAcmCertificate_wildcardAndRootSan(t *testing.T) {
 	})
 }
 
+func TestAccAWSAcmCertificate_privateCert(t *testing.T) {
+	commonName := fmt.Sprintf("%s.terraformtesting.com", acctest.RandString(8))
+	domain := fmt.Sprintf("www.%s", commonName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAcmCertificateConfig_privateCert(commonName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("aws_acm_certificate.cert", "arn", certificateArnRegex),
+					resource.TestCheckResourceAttrPair("aws_acm_certificate.cert", "certificate_authority_arn", "aws_acmpca_certificate_authority.test", "arn"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_name", domain),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "domain_validation_options.#", "0"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_emails.#", "0"),
+					resource.TestCheckResourceAttr("aws_acm_certificate.cert", "validation_method", "NONE"),
+				),
+			},
+			{
+				ResourceName:      "aws_acm_certificate.cert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSAcmCertificate_tags(t *testing.T) {
 	rootDomain := testAccAwsAcmCertificateDomainFromEnv(t)
 
@@ -401,6 +430,15 @@ resource "aws_acm_certificate" "cert" {
 `, domainName, subjectAlternativeNames, validationMethod)
 }
 
+func testAccAcmCertificateConfig_privateCert(commonName, domainName string) string {
+	return testAccAwsAcmpcaCertificateAuthorityCertificateConfig(commonName) + fmt.Sprintf(`
+resource "aws_acm_certificate" "cert" {
+  domain_name               = %q
+  certificate_authority_arn = "${aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn}"
+}
+`, domainName)
+}
+
 func testAccAcmCertificateConfig_oneTag(domainName, validationMethod, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "cert" {
@@ -281,7 +281,7 @@ func resourceAwsAcmpcaCertificateAuthorityCreate(d *schema.ResourceData, meta in
 
 	d.SetId(aws.StringValue(output.CertificateAuthorityArn))
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input := &acmpca.TagCertificateAuthorityInput{
 			CertificateAuthorityArn: aws.String(d.Id()),
 			Tags:                    tagsFromMapACMPCA(v.(map[string]interface{})),
@@ -448,8 +448,8 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsACMPCA(tagsFromMapACMPCA(o), tagsFromMapACMPCA(n))
@@ -482,6 +482,20 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 func resourceAwsAcmpcaCertificateAuthorityDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).acmpcaconn
 
+	// A certificate authority with an installed certificate must be disabled before deletion
+	if d.Get("status").(string) == acmpca.CertificateAuthorityStatusActive {
+		updateInput := &acmpca.UpdateCertificateAuthorityInput{
+			CertificateAuthorityArn: aws.String(d.Id()),
+			Status:                  aws.String(acmpca.CertificateAuthorityStatusDisabled),
+		}
+
+		log.Printf("[DEBUG] Disabling ACMPCA Certificate Authority: %s", updateInput)
+		_, err := conn.UpdateCertificateAuthority(updateInput)
+		if err != nil && !isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("error disabling ACMPCA Certificate Authority: %s", err)
+		}
+	}
+
 	input := &acmpca.DeleteCertificateAuthorityInput{
 		CertificateAuthorityArn: aws.String(d.Id()),
 	}
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAcmpcaCertificateAuthorityCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaCertificateAuthorityCertificateCreate,
+		Read:   resourceAwsAcmpcaCertificateAuthorityCertificateRead,
+		Delete: resourceAwsAcmpcaCertificateAuthorityCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"certificate_chain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	certificateAuthorityArn := d.Get("certificate_authority_arn").(string)
+
+	input := &acmpca.ImportCertificateAuthorityCertificateInput{
+		Certificate:             []byte(d.Get("certificate").(string)),
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+		CertificateChain:        []byte(d.Get("certificate_chain").(string)),
+	}
+
+	log.Printf("[DEBUG] Importing ACMPCA Certificate Authority Certificate: %s", certificateAuthorityArn)
+	_, err := conn.ImportCertificateAuthorityCertificate(input)
+	if err != nil {
+		return fmt.Errorf("error importing ACMPCA Certificate Authority (%s) Certificate: %s", certificateAuthorityArn, err)
+	}
+
+	d.SetId(certificateAuthorityArn)
+
+	return resourceAwsAcmpcaCertificateAuthorityCertificateRead(d, meta)
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.GetCertificateAuthorityCertificateInput{
+		CertificateAuthorityArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate Authority Certificate: %s", input)
+
+	output, err := conn.GetCertificateAuthorityCertificate(input)
+	if err != nil {
+		// InvalidStateException is returned while the CA has no certificate installed
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") || isAWSErr(err, acmpca.ErrCodeInvalidStateException, "") {
+			log.Printf("[WARN] ACMPCA Certificate Authority Certificate %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading ACMPCA Certificate Authority Certificate: %s", err)
+	}
+
+	d.Set("certificate_authority_arn", d.Id())
+	d.Set("certificate", output.Certificate)
+	d.Set("certificate_chain", output.CertificateChain)
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to remove an installed certificate authority certificate.
+	log.Printf("[WARN] ACMPCA Certificate Authority Certificate %q cannot be removed from the certificate authority - removing from state only", d.Id())
+
+	return nil
+}
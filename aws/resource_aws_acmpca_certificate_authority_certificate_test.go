@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAwsAcmpcaCertificateAuthorityCertificate_Basic(t *testing.T) {
+	var certificateAuthority acmpca.CertificateAuthority
+	commonName := fmt.Sprintf("%s.terraformtesting.com", acctest.RandString(8))
+	resourceName := "aws_acmpca_certificate_authority_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityCertificateConfig(commonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaCertificateAuthorityExists("aws_acmpca_certificate_authority.test", &certificateAuthority),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", "aws_acmpca_certificate_authority.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate", "tls_locally_signed_cert.test", "cert_pem"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_chain", "tls_self_signed_cert.root", "cert_pem"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityCertificateConfig(commonName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_acmpca_certificate_authority.test", "status", acmpca.CertificateAuthorityStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsAcmpcaCertificateAuthorityCertificateConfig(commonName string) string {
+	return fmt.Sprintf(`
+resource "tls_private_key" "root" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "root" {
+  key_algorithm         = "RSA"
+  private_key_pem       = "${tls_private_key.root.private_key_pem}"
+  is_ca_certificate     = true
+  validity_period_hours = 24
+
+  subject {
+    common_name = "root.%[1]s"
+  }
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[1]q
+    }
+  }
+}
+
+resource "tls_locally_signed_cert" "test" {
+  cert_request_pem      = "${aws_acmpca_certificate_authority.test.certificate_signing_request}"
+  ca_key_algorithm      = "RSA"
+  ca_private_key_pem    = "${tls_private_key.root.private_key_pem}"
+  ca_cert_pem           = "${tls_self_signed_cert.root.cert_pem}"
+  is_ca_certificate     = true
+  validity_period_hours = 24
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = "${aws_acmpca_certificate_authority.test.arn}"
+  certificate               = "${tls_locally_signed_cert.test.cert_pem}"
+  certificate_chain         = "${tls_self_signed_cert.root.cert_pem}"
+}
+`, commonName)
+}
@@ -141,7 +141,7 @@ func resourceAwsApiGatewayStageCreate(d *schema.ResourceData, meta interface{})
 		}
 		input.Variables = aws.StringMap(variables)
 	}
-	if vars, ok := d.GetOk("tags"); ok {
+	if vars, ok := d.GetOk("tags_all"); ok {
 		newMap := make(map[string]string, len(vars.(map[string]interface{})))
 		for k, v := range vars.(map[string]interface{}) {
 			newMap[k] = v.(string)
@@ -11,8 +11,6 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-const awsAppautoscalingScheduleTimeLayout = "2006-01-02T15:04:05Z"
-
 func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsAppautoscalingScheduledActionPut,
@@ -66,14 +64,16 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 				ForceNew: true,
 			},
 			"start_time": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRFC3339TimeString,
 			},
 			"end_time": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRFC3339TimeString,
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -109,18 +109,20 @@ func resourceAwsAppautoscalingScheduledActionPut(d *schema.ResourceData, meta in
 		input.ScalableTargetAction = sta
 	}
 	if v, ok := d.GetOk("start_time"); ok {
-		t, err := time.Parse(awsAppautoscalingScheduleTimeLayout, v.(string))
+		// Times with a UTC offset are converted, the API only accepts UTC
+		t, err := time.Parse(time.RFC3339, v.(string))
 		if err != nil {
 			return fmt.Errorf("Error Parsing Appautoscaling Scheduled Action Start Time: %s", err.Error())
 		}
-		input.StartTime = aws.Time(t)
+		input.StartTime = aws.Time(t.UTC())
 	}
 	if v, ok := d.GetOk("end_time"); ok {
-		t, err := time.Parse(awsAppautoscalingScheduleTimeLayout, v.(string))
+		// Times with a UTC offset are converted, the API only accepts UTC
+		t, err := time.Parse(time.RFC3339, v.(string))
 		if err != nil {
 			return fmt.Errorf("Error Parsing Appautoscaling Scheduled Action End Time: %s", err.Error())
 		}
-		input.EndTime = aws.Time(t)
+		input.EndTime = aws.Time(t.UTC())
 	}
 
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -146,26 +148,47 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 	conn := meta.(*AWSClient).appautoscalingconn
 
 	saName := d.Get("name").(string)
+	resourceId := d.Get("resource_id").(string)
+	scalableDimension := d.Get("scalable_dimension").(string)
+
+	// Scheduled action names are only unique per scalable target, so narrow
+	// the search to this target to avoid matching another target's action
 	input := &applicationautoscaling.DescribeScheduledActionsInput{
+		ResourceId:           aws.String(resourceId),
 		ScheduledActionNames: []*string{aws.String(saName)},
 		ServiceNamespace:     aws.String(d.Get("service_namespace").(string)),
 	}
-	resp, err := conn.DescribeScheduledActions(input)
-	if err != nil {
-		return err
+	if scalableDimension != "" {
+		input.ScalableDimension = aws.String(scalableDimension)
 	}
-	if len(resp.ScheduledActions) < 1 {
+
+	var scheduledAction *applicationautoscaling.ScheduledAction
+	for scheduledAction == nil {
+		resp, err := conn.DescribeScheduledActions(input)
+		if err != nil {
+			return err
+		}
+		for _, sa := range resp.ScheduledActions {
+			if aws.StringValue(sa.ScheduledActionName) != saName || aws.StringValue(sa.ResourceId) != resourceId {
+				continue
+			}
+			if scalableDimension != "" && aws.StringValue(sa.ScalableDimension) != scalableDimension {
+				continue
+			}
+			scheduledAction = sa
+			break
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	if scheduledAction == nil {
 		log.Printf("[WARN] Application Autoscaling Scheduled Action (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
-	if len(resp.ScheduledActions) != 1 {
-		return fmt.Errorf("Expected 1 scheduled action under %s, found %d", saName, len(resp.ScheduledActions))
-	}
-	if *resp.ScheduledActions[0].ScheduledActionName != saName {
-		return fmt.Errorf("Scheduled Action (%s) not found", saName)
-	}
-	d.Set("arn", resp.ScheduledActions[0].ScheduledActionARN)
+	d.Set("arn", scheduledAction.ScheduledActionARN)
 	return nil
 }
 
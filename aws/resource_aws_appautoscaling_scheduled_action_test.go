@@ -29,6 +29,27 @@ func TestAccAWSAppautoscalingScheduledAction_dynamo(t *testing.T) {
 	})
 }
 
+func TestAccAWSAppautoscalingScheduledAction_MultipleOnSameTarget(t *testing.T) {
+	ts := time.Now().AddDate(0, 0, 1).Format("2006-01-02T15:04:05")
+	startTime := time.Now().AddDate(0, 0, 1).In(time.FixedZone("JST", 9*60*60)).Format(time.RFC3339)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppautoscalingScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppautoscalingScheduledActionConfig_MultipleOnSameTarget(acctest.RandString(5), ts, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppautoscalingScheduledActionExists("aws_appautoscaling_scheduled_action.read"),
+					testAccCheckAwsAppautoscalingScheduledActionExists("aws_appautoscaling_scheduled_action.write"),
+					resource.TestCheckResourceAttrSet("aws_appautoscaling_scheduled_action.read", "arn"),
+					resource.TestCheckResourceAttrSet("aws_appautoscaling_scheduled_action.write", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAppautoscalingScheduledAction_ECS(t *testing.T) {
 	ts := time.Now().AddDate(0, 0, 1).Format("2006-01-02T15:04:05")
 	resource.Test(t, resource.TestCase{
@@ -150,6 +171,65 @@ resource "aws_appautoscaling_scheduled_action" "hoge" {
 `, rName, rName, ts)
 }
 
+func testAccAppautoscalingScheduledActionConfig_MultipleOnSameTarget(rName, ts, startTime string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "hoge" {
+  name           = "tf-ddb-%[1]s"
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "UserID"
+
+  attribute {
+    name = "UserID"
+    type = "S"
+  }
+}
+
+resource "aws_appautoscaling_target" "read" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/${aws_dynamodb_table.hoge.name}"
+  scalable_dimension = "dynamodb:table:ReadCapacityUnits"
+  min_capacity       = 1
+  max_capacity       = 10
+}
+
+resource "aws_appautoscaling_target" "write" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/${aws_dynamodb_table.hoge.name}"
+  scalable_dimension = "dynamodb:table:WriteCapacityUnits"
+  min_capacity       = 1
+  max_capacity       = 10
+}
+
+resource "aws_appautoscaling_scheduled_action" "read" {
+  name               = "tf-appauto-%[1]s"
+  service_namespace  = "${aws_appautoscaling_target.read.service_namespace}"
+  resource_id        = "${aws_appautoscaling_target.read.resource_id}"
+  scalable_dimension = "${aws_appautoscaling_target.read.scalable_dimension}"
+  schedule           = "at(%[2]s)"
+  start_time         = %[3]q
+
+  scalable_target_action {
+    min_capacity = 1
+    max_capacity = 10
+  }
+}
+
+resource "aws_appautoscaling_scheduled_action" "write" {
+  name               = "tf-appauto-%[1]s"
+  service_namespace  = "${aws_appautoscaling_target.write.service_namespace}"
+  resource_id        = "${aws_appautoscaling_target.write.resource_id}"
+  scalable_dimension = "${aws_appautoscaling_target.write.scalable_dimension}"
+  schedule           = "at(%[2]s)"
+
+  scalable_target_action {
+    min_capacity = 1
+    max_capacity = 10
+  }
+}
+`, rName, ts, startTime)
+}
+
 func testAccAppautoscalingScheduledActionConfig_ECS(rName, ts string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "hoge" {
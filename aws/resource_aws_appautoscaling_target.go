@@ -35,9 +35,10 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 				ForceNew: true,
 			},
 			"role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"scalable_dimension": {
 				Type:     schema.TypeString,
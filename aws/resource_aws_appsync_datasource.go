@@ -107,8 +107,9 @@ func resourceAwsAppsyncDatasource() *schema.Resource {
 				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config"},
 			},
 			"service_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"arn": {
 				Type:     schema.TypeString,
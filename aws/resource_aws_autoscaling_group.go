@@ -260,8 +260,9 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 							Optional: true,
 						},
 						"role_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -294,42 +295,38 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 	}
 }
 
-func generatePutLifecycleHookInputs(asgName string, cfgs []interface{}) []autoscaling.PutLifecycleHookInput {
-	res := make([]autoscaling.PutLifecycleHookInput, 0, len(cfgs))
+func expandAutoscalingLifecycleHookSpecifications(cfgs []interface{}) []*autoscaling.LifecycleHookSpecification {
+	res := make([]*autoscaling.LifecycleHookSpecification, 0, len(cfgs))
 
 	for _, raw := range cfgs {
 		cfg := raw.(map[string]interface{})
 
-		input := autoscaling.PutLifecycleHookInput{
-			AutoScalingGroupName: &asgName,
-			LifecycleHookName:    aws.String(cfg["name"].(string)),
+		spec := &autoscaling.LifecycleHookSpecification{
+			LifecycleHookName:   aws.String(cfg["name"].(string)),
+			LifecycleTransition: aws.String(cfg["lifecycle_transition"].(string)),
 		}
 
 		if v, ok := cfg["default_result"]; ok && v.(string) != "" {
-			input.DefaultResult = aws.String(v.(string))
+			spec.DefaultResult = aws.String(v.(string))
 		}
 
 		if v, ok := cfg["heartbeat_timeout"]; ok && v.(int) > 0 {
-			input.HeartbeatTimeout = aws.Int64(int64(v.(int)))
-		}
-
-		if v, ok := cfg["lifecycle_transition"]; ok && v.(string) != "" {
-			input.LifecycleTransition = aws.String(v.(string))
+			spec.HeartbeatTimeout = aws.Int64(int64(v.(int)))
 		}
 
 		if v, ok := cfg["notification_metadata"]; ok && v.(string) != "" {
-			input.NotificationMetadata = aws.String(v.(string))
+			spec.NotificationMetadata = aws.String(v.(string))
 		}
 
 		if v, ok := cfg["notification_target_arn"]; ok && v.(string) != "" {
-			input.NotificationTargetARN = aws.String(v.(string))
+			spec.NotificationTargetARN = aws.String(v.(string))
 		}
 
 		if v, ok := cfg["role_arn"]; ok && v.(string) != "" {
-			input.RoleARN = aws.String(v.(string))
+			spec.RoleARN = aws.String(v.(string))
 		}
 
-		res = append(res, input)
+		res = append(res, spec)
 	}
 
 	return res
@@ -352,35 +349,19 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 
 	createOpts := autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName:             aws.String(asgName),
+		MaxSize:                          aws.Int64(int64(d.Get("max_size").(int))),
+		MinSize:                          aws.Int64(int64(d.Get("min_size").(int))),
 		NewInstancesProtectedFromScaleIn: aws.Bool(d.Get("protect_from_scale_in").(bool)),
 	}
-	updateOpts := autoscaling.UpdateAutoScalingGroupInput{
-		AutoScalingGroupName: aws.String(asgName),
-	}
 
-	initialLifecycleHooks := d.Get("initial_lifecycle_hook").(*schema.Set).List()
-	twoPhases := len(initialLifecycleHooks) > 0
-
-	minSize := aws.Int64(int64(d.Get("min_size").(int)))
-	maxSize := aws.Int64(int64(d.Get("max_size").(int)))
-
-	if twoPhases {
-		createOpts.MinSize = aws.Int64(int64(0))
-		createOpts.MaxSize = aws.Int64(int64(0))
-
-		updateOpts.MinSize = minSize
-		updateOpts.MaxSize = maxSize
-
-		if v, ok := d.GetOk("desired_capacity"); ok {
-			updateOpts.DesiredCapacity = aws.Int64(int64(v.(int)))
-		}
-	} else {
-		createOpts.MinSize = minSize
-		createOpts.MaxSize = maxSize
+	if v, ok := d.GetOk("desired_capacity"); ok {
+		createOpts.DesiredCapacity = aws.Int64(int64(v.(int)))
+	}
 
-		if v, ok := d.GetOk("desired_capacity"); ok {
-			createOpts.DesiredCapacity = aws.Int64(int64(v.(int)))
-		}
+	// Lifecycle hooks are created together with the group so that they
+	// apply to the very first instances launched into it
+	if v, ok := d.GetOk("initial_lifecycle_hook"); ok && v.(*schema.Set).Len() > 0 {
+		createOpts.LifecycleHookSpecificationList = expandAutoscalingLifecycleHookSpecifications(v.(*schema.Set).List())
 	}
 
 	launchConfigurationValue, launchConfigurationOk := d.GetOk("launch_configuration")
@@ -474,6 +455,11 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 			return resource.RetryableError(err)
 		}
 
+		// The role used by an initial lifecycle hook may not have propagated yet
+		if isAWSErr(err, "ValidationError", "Unable to publish test message to notification target") {
+			return resource.RetryableError(err)
+		}
+
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -487,19 +473,6 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(d.Get("name").(string))
 	log.Printf("[INFO] AutoScaling Group ID: %s", d.Id())
 
-	if twoPhases {
-		for _, hook := range generatePutLifecycleHookInputs(asgName, initialLifecycleHooks) {
-			if err = resourceAwsAutoscalingLifecycleHookPutOp(conn, &hook); err != nil {
-				return fmt.Errorf("Error creating initial lifecycle hooks: %s", err)
-			}
-		}
-
-		_, err = conn.UpdateAutoScalingGroup(&updateOpts)
-		if err != nil {
-			return fmt.Errorf("Error setting AutoScaling Group initial capacity: %s", err)
-		}
-	}
-
 	if err := waitForASGCapacity(d, meta, capacitySatisfiedCreate); err != nil {
 		return err
 	}
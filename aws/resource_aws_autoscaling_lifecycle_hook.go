@@ -52,8 +52,9 @@ func resourceAwsAutoscalingLifecycleHook() *schema.Resource {
 				Optional: true,
 			},
 			"role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 		},
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsBudgetsBudget() *schema.Resource {
@@ -126,9 +127,80 @@ func resourceAwsBudgetsBudget() *schema.Resource {
 				Required: true,
 			},
 			"cost_filters": {
-				Type:     schema.TypeMap,
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"cost_filter"},
+			},
+			"cost_filter": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"cost_filters"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"notification": {
+				Type:     schema.TypeSet,
 				Optional: true,
-				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ComparisonOperatorEqualTo,
+								budgets.ComparisonOperatorGreaterThan,
+								budgets.ComparisonOperatorLessThan,
+							}, false),
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.NotificationTypeActual,
+								budgets.NotificationTypeForecasted,
+							}, false),
+						},
+						"subscriber_email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subscriber_sns_topic_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateArn,
+							},
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"threshold_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								budgets.ThresholdTypeAbsoluteValue,
+								budgets.ThresholdTypePercentage,
+							}, false),
+						},
+					},
+				},
 			},
 		},
 		Create: resourceAwsBudgetsBudgetCreate,
@@ -162,10 +234,21 @@ func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) er
 		accountID = meta.(*AWSClient).accountid
 	}
 
-	_, err = client.CreateBudget(&budgets.CreateBudgetInput{
+	notifications, err := expandBudgetsNotifications(d.Get("notification").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	input := &budgets.CreateBudgetInput{
 		AccountId: aws.String(accountID),
 		Budget:    budget,
-	})
+	}
+
+	if len(notifications) > 0 {
+		input.NotificationsWithSubscribers = notifications
+	}
+
+	_, err = client.CreateBudget(input)
 	if err != nil {
 		return fmt.Errorf("create budget failed: %v", err)
 	}
@@ -209,6 +292,10 @@ func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error setting cost_filters: %s", err)
 	}
 
+	if err := d.Set("cost_filter", flattenBudgetsCostFilters(budget.CostFilters)); err != nil {
+		return fmt.Errorf("error setting cost_filter: %s", err)
+	}
+
 	if err := d.Set("cost_types", flattenBudgetsCostTypes(budget.CostTypes)); err != nil {
 		return fmt.Errorf("error setting cost_types: %s %s", err, budget.CostTypes)
 	}
@@ -227,11 +314,20 @@ func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.Set("time_unit", budget.TimeUnit)
 
+	notifications, err := describeBudgetsNotificationsWithSubscribers(client, accountID, budgetName)
+	if err != nil {
+		return fmt.Errorf("error reading Budget (%s) notifications: %s", d.Id(), err)
+	}
+
+	if err := d.Set("notification", flattenBudgetsNotifications(notifications)); err != nil {
+		return fmt.Errorf("error setting notification: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
-	accountID, _, err := decodeBudgetsBudgetID(d.Id())
+	accountID, budgetName, err := decodeBudgetsBudgetID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -250,6 +346,12 @@ func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("update budget failed: %v", err)
 	}
 
+	if d.HasChange("notification") {
+		if err := updateBudgetsNotifications(client, d, accountID, budgetName); err != nil {
+			return fmt.Errorf("error updating Budget (%s) notifications: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsBudgetsBudgetRead(d, meta)
 }
 
@@ -319,9 +421,18 @@ func expandBudgetsBudgetUnmarshal(d *schema.ResourceData) (*budgets.Budget, erro
 	costTypes := expandBudgetsCostTypesUnmarshal(d.Get("cost_types").([]interface{}))
 	budgetTimeUnit := d.Get("time_unit").(string)
 	budgetCostFilters := make(map[string][]*string)
-	for k, v := range d.Get("cost_filters").(map[string]interface{}) {
-		filterValue := v.(string)
-		budgetCostFilters[k] = append(budgetCostFilters[k], aws.String(filterValue))
+	// Both cost filter arguments are Computed, so only use the map form when
+	// it is the one being changed in configuration.
+	if d.HasChange("cost_filters") && !d.HasChange("cost_filter") {
+		for k, v := range d.Get("cost_filters").(map[string]interface{}) {
+			filterValue := v.(string)
+			budgetCostFilters[k] = append(budgetCostFilters[k], aws.String(filterValue))
+		}
+	} else {
+		for _, v := range d.Get("cost_filter").(*schema.Set).List() {
+			m := v.(map[string]interface{})
+			budgetCostFilters[m["name"].(string)] = expandStringList(m["values"].([]interface{}))
+		}
 	}
 
 	budgetTimePeriodStart, err := time.Parse("2006-01-02_15:04", d.Get("time_period_start").(string))
@@ -397,3 +508,198 @@ func expandBudgetsCostTypesUnmarshal(budgetCostTypes []interface{}) *budgets.Cos
 
 	return costTypes
 }
+
+func flattenBudgetsCostFilters(costFilters map[string][]*string) []interface{} {
+	l := make([]interface{}, 0, len(costFilters))
+	for k, v := range costFilters {
+		l = append(l, map[string]interface{}{
+			"name":   k,
+			"values": flattenStringList(v),
+		})
+	}
+
+	return l
+}
+
+func expandBudgetsNotifications(l []interface{}) ([]*budgets.NotificationWithSubscribers, error) {
+	notifications := make([]*budgets.NotificationWithSubscribers, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		notification := &budgets.NotificationWithSubscribers{
+			Notification: &budgets.Notification{
+				ComparisonOperator: aws.String(m["comparison_operator"].(string)),
+				NotificationType:   aws.String(m["notification_type"].(string)),
+				Threshold:          aws.Float64(m["threshold"].(float64)),
+				ThresholdType:      aws.String(m["threshold_type"].(string)),
+			},
+		}
+
+		for _, address := range m["subscriber_email_addresses"].(*schema.Set).List() {
+			notification.Subscribers = append(notification.Subscribers, &budgets.Subscriber{
+				Address:          aws.String(address.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeEmail),
+			})
+		}
+
+		for _, address := range m["subscriber_sns_topic_arns"].(*schema.Set).List() {
+			notification.Subscribers = append(notification.Subscribers, &budgets.Subscriber{
+				Address:          aws.String(address.(string)),
+				SubscriptionType: aws.String(budgets.SubscriptionTypeSns),
+			})
+		}
+
+		if len(notification.Subscribers) == 0 {
+			return nil, fmt.Errorf("budget notification must have at least one subscriber_email_addresses or subscriber_sns_topic_arns")
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+func flattenBudgetsNotifications(notifications []*budgets.NotificationWithSubscribers) []interface{} {
+	l := make([]interface{}, 0, len(notifications))
+	for _, notification := range notifications {
+		var emailAddresses, snsTopicArns []interface{}
+		for _, subscriber := range notification.Subscribers {
+			switch aws.StringValue(subscriber.SubscriptionType) {
+			case budgets.SubscriptionTypeEmail:
+				emailAddresses = append(emailAddresses, aws.StringValue(subscriber.Address))
+			case budgets.SubscriptionTypeSns:
+				snsTopicArns = append(snsTopicArns, aws.StringValue(subscriber.Address))
+			}
+		}
+
+		l = append(l, map[string]interface{}{
+			"comparison_operator":        aws.StringValue(notification.Notification.ComparisonOperator),
+			"notification_type":          aws.StringValue(notification.Notification.NotificationType),
+			"subscriber_email_addresses": schema.NewSet(schema.HashString, emailAddresses),
+			"subscriber_sns_topic_arns":  schema.NewSet(schema.HashString, snsTopicArns),
+			"threshold":                  aws.Float64Value(notification.Notification.Threshold),
+			"threshold_type":             aws.StringValue(notification.Notification.ThresholdType),
+		})
+	}
+
+	return l
+}
+
+func describeBudgetsNotificationsWithSubscribers(conn *budgets.Budgets, accountID, budgetName string) ([]*budgets.NotificationWithSubscribers, error) {
+	var notifications []*budgets.NotificationWithSubscribers
+
+	input := &budgets.DescribeNotificationsForBudgetInput{
+		AccountId:  aws.String(accountID),
+		BudgetName: aws.String(budgetName),
+	}
+
+	for {
+		output, err := conn.DescribeNotificationsForBudget(input)
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return notifications, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, notification := range output.Notifications {
+			subscribers, err := describeBudgetsSubscribersForNotification(conn, accountID, budgetName, notification)
+			if err != nil {
+				return nil, err
+			}
+
+			notifications = append(notifications, &budgets.NotificationWithSubscribers{
+				Notification: notification,
+				Subscribers:  subscribers,
+			})
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return notifications, nil
+}
+
+func describeBudgetsSubscribersForNotification(conn *budgets.Budgets, accountID, budgetName string, notification *budgets.Notification) ([]*budgets.Subscriber, error) {
+	var subscribers []*budgets.Subscriber
+
+	input := &budgets.DescribeSubscribersForNotificationInput{
+		AccountId:    aws.String(accountID),
+		BudgetName:   aws.String(budgetName),
+		Notification: notification,
+	}
+
+	for {
+		output, err := conn.DescribeSubscribersForNotification(input)
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			return subscribers, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		subscribers = append(subscribers, output.Subscribers...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return subscribers, nil
+}
+
+// updateBudgetsNotifications replaces changed notifications. Removed
+// notifications are deleted before new ones are created so a notification
+// whose subscribers changed does not collide with its previous definition.
+func updateBudgetsNotifications(conn *budgets.Budgets, d *schema.ResourceData, accountID, budgetName string) error {
+	o, n := d.GetChange("notification")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	removed, err := expandBudgetsNotifications(os.Difference(ns).List())
+	if err != nil {
+		return err
+	}
+
+	added, err := expandBudgetsNotifications(ns.Difference(os).List())
+	if err != nil {
+		return err
+	}
+
+	for _, notification := range removed {
+		input := &budgets.DeleteNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification.Notification,
+		}
+
+		log.Printf("[DEBUG] Deleting Budget notification: %s", input)
+		_, err := conn.DeleteNotification(input)
+		if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, notification := range added {
+		input := &budgets.CreateNotificationInput{
+			AccountId:    aws.String(accountID),
+			BudgetName:   aws.String(budgetName),
+			Notification: notification.Notification,
+			Subscribers:  notification.Subscribers,
+		}
+
+		log.Printf("[DEBUG] Creating Budget notification: %s", input)
+		if _, err := conn.CreateNotification(input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -105,6 +105,50 @@ func TestAccAWSBudgetsBudget_prefix(t *testing.T) {
 	})
 }
 
+func TestAccAWSBudgetsBudget_costFilter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_CostFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cost_filter.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudget_notification(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_budgets_budget.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Notification(rName, 100, `"test1@example.com", "test2@example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSBudgetsBudgetConfig_Notification(rName, 80, `"test1@example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAWSBudgetsBudgetExists(resourceName string, config budgets.Budget) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -410,3 +454,54 @@ resource "aws_budgets_budget" "foo" {
 	t.Execute(&doc, budgetConfig)
 	return doc.String()
 }
+
+func testAccAWSBudgetsBudgetConfig_CostFilter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name              = %q
+  budget_type       = "COST"
+  limit_amount      = "100.0"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_12:00"
+  time_unit         = "MONTHLY"
+
+  cost_filter {
+    name   = "AZ"
+    values = ["us-east-1a", "us-east-1b"]
+  }
+}
+`, rName)
+}
+
+func testAccAWSBudgetsBudgetConfig_Notification(rName string, threshold int, emailAddresses string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "COST"
+  limit_amount      = "100.0"
+  limit_unit        = "USD"
+  time_period_start = "2017-01-01_12:00"
+  time_unit         = "MONTHLY"
+
+  notification {
+    comparison_operator        = "GREATER_THAN"
+    notification_type          = "ACTUAL"
+    threshold                  = %[2]d
+    threshold_type             = "PERCENTAGE"
+    subscriber_email_addresses = [%[3]s]
+  }
+
+  notification {
+    comparison_operator       = "GREATER_THAN"
+    notification_type         = "FORECASTED"
+    threshold                 = 100
+    threshold_type            = "PERCENTAGE"
+    subscriber_sns_topic_arns = ["${aws_sns_topic.test.arn}"]
+  }
+}
+`, rName, threshold, emailAddresses)
+}
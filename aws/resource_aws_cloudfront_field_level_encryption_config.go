@@ -0,0 +1,334 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontFieldLevelEncryptionConfigCreate,
+		Read:   resourceAwsCloudFrontFieldLevelEncryptionConfigRead,
+		Update: resourceAwsCloudFrontFieldLevelEncryptionConfigUpdate,
+		Delete: resourceAwsCloudFrontFieldLevelEncryptionConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"caller_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_type_profile_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type_profiles": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content_type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"format": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											cloudfront.FormatUrlencoded,
+										}, false),
+									},
+									"profile_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"forward_when_content_type_is_unknown": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_arg_profile_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forward_when_query_arg_profile_is_unknown": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"query_arg_profiles": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"profile_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"query_arg": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.CreateFieldLevelEncryptionConfigInput{
+		FieldLevelEncryptionConfig: expandCloudFrontFieldLevelEncryptionConfig(d, time.Now().Format(time.RFC3339Nano)),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Field Level Encryption Config: %s", input)
+	output, err := conn.CreateFieldLevelEncryptionConfig(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Field Level Encryption Config: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FieldLevelEncryption.Id))
+
+	return resourceAwsCloudFrontFieldLevelEncryptionConfigRead(d, meta)
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.GetFieldLevelEncryptionInput{
+		Id: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetFieldLevelEncryption(input)
+	if err != nil {
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionConfig, "") {
+			log.Printf("[WARN] CloudFront Field Level Encryption Config %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading CloudFront Field Level Encryption Config (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.FieldLevelEncryption == nil || output.FieldLevelEncryption.FieldLevelEncryptionConfig == nil {
+		log.Printf("[WARN] CloudFront Field Level Encryption Config %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	config := output.FieldLevelEncryption.FieldLevelEncryptionConfig
+
+	d.Set("caller_reference", config.CallerReference)
+	d.Set("comment", config.Comment)
+
+	if err := d.Set("content_type_profile_config", flattenCloudFrontContentTypeProfileConfig(config.ContentTypeProfileConfig)); err != nil {
+		return fmt.Errorf("error setting content_type_profile_config: %s", err)
+	}
+
+	d.Set("etag", output.ETag)
+
+	if err := d.Set("query_arg_profile_config", flattenCloudFrontQueryArgProfileConfig(config.QueryArgProfileConfig)); err != nil {
+		return fmt.Errorf("error setting query_arg_profile_config: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdateFieldLevelEncryptionConfigInput{
+		FieldLevelEncryptionConfig: expandCloudFrontFieldLevelEncryptionConfig(d, d.Get("caller_reference").(string)),
+		Id:                         aws.String(d.Id()),
+		IfMatch:                    aws.String(d.Get("etag").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Field Level Encryption Config: %s", input)
+	_, err := conn.UpdateFieldLevelEncryptionConfig(input)
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Field Level Encryption Config (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontFieldLevelEncryptionConfigRead(d, meta)
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.DeleteFieldLevelEncryptionConfigInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFront Field Level Encryption Config: %s", input)
+	_, err := conn.DeleteFieldLevelEncryptionConfig(input)
+	if err != nil {
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionConfig, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting CloudFront Field Level Encryption Config (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontFieldLevelEncryptionConfig(d *schema.ResourceData, callerReference string) *cloudfront.FieldLevelEncryptionConfig {
+	config := &cloudfront.FieldLevelEncryptionConfig{
+		CallerReference:          aws.String(callerReference),
+		ContentTypeProfileConfig: expandCloudFrontContentTypeProfileConfig(d.Get("content_type_profile_config").([]interface{})),
+		QueryArgProfileConfig:    expandCloudFrontQueryArgProfileConfig(d.Get("query_arg_profile_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		config.Comment = aws.String(v.(string))
+	}
+
+	return config
+}
+
+func expandCloudFrontContentTypeProfileConfig(l []interface{}) *cloudfront.ContentTypeProfileConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	profiles := m["content_type_profiles"].(*schema.Set).List()
+	items := make([]*cloudfront.ContentTypeProfile, 0, len(profiles))
+
+	for _, raw := range profiles {
+		profile := raw.(map[string]interface{})
+
+		item := &cloudfront.ContentTypeProfile{
+			ContentType: aws.String(profile["content_type"].(string)),
+			Format:      aws.String(profile["format"].(string)),
+		}
+
+		if v := profile["profile_id"].(string); v != "" {
+			item.ProfileId = aws.String(v)
+		}
+
+		items = append(items, item)
+	}
+
+	return &cloudfront.ContentTypeProfileConfig{
+		ContentTypeProfiles: &cloudfront.ContentTypeProfiles{
+			Items:    items,
+			Quantity: aws.Int64(int64(len(items))),
+		},
+		ForwardWhenContentTypeIsUnknown: aws.Bool(m["forward_when_content_type_is_unknown"].(bool)),
+	}
+}
+
+func flattenCloudFrontContentTypeProfileConfig(contentTypeProfileConfig *cloudfront.ContentTypeProfileConfig) []interface{} {
+	if contentTypeProfileConfig == nil {
+		return []interface{}{}
+	}
+
+	profiles := make([]interface{}, 0)
+
+	if contentTypeProfileConfig.ContentTypeProfiles != nil {
+		for _, profile := range contentTypeProfileConfig.ContentTypeProfiles.Items {
+			if profile == nil {
+				continue
+			}
+
+			profiles = append(profiles, map[string]interface{}{
+				"content_type": aws.StringValue(profile.ContentType),
+				"format":       aws.StringValue(profile.Format),
+				"profile_id":   aws.StringValue(profile.ProfileId),
+			})
+		}
+	}
+
+	m := map[string]interface{}{
+		"content_type_profiles":                profiles,
+		"forward_when_content_type_is_unknown": aws.BoolValue(contentTypeProfileConfig.ForwardWhenContentTypeIsUnknown),
+	}
+
+	return []interface{}{m}
+}
+
+func expandCloudFrontQueryArgProfileConfig(l []interface{}) *cloudfront.QueryArgProfileConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	profiles := m["query_arg_profiles"].(*schema.Set).List()
+	items := make([]*cloudfront.QueryArgProfile, 0, len(profiles))
+
+	for _, raw := range profiles {
+		profile := raw.(map[string]interface{})
+
+		items = append(items, &cloudfront.QueryArgProfile{
+			ProfileId: aws.String(profile["profile_id"].(string)),
+			QueryArg:  aws.String(profile["query_arg"].(string)),
+		})
+	}
+
+	return &cloudfront.QueryArgProfileConfig{
+		ForwardWhenQueryArgProfileIsUnknown: aws.Bool(m["forward_when_query_arg_profile_is_unknown"].(bool)),
+		QueryArgProfiles: &cloudfront.QueryArgProfiles{
+			Items:    items,
+			Quantity: aws.Int64(int64(len(items))),
+		},
+	}
+}
+
+func flattenCloudFrontQueryArgProfileConfig(queryArgProfileConfig *cloudfront.QueryArgProfileConfig) []interface{} {
+	if queryArgProfileConfig == nil {
+		return []interface{}{}
+	}
+
+	profiles := make([]interface{}, 0)
+
+	if queryArgProfileConfig.QueryArgProfiles != nil {
+		for _, profile := range queryArgProfileConfig.QueryArgProfiles.Items {
+			if profile == nil {
+				continue
+			}
+
+			profiles = append(profiles, map[string]interface{}{
+				"profile_id": aws.StringValue(profile.ProfileId),
+				"query_arg":  aws.StringValue(profile.QueryArg),
+			})
+		}
+	}
+
+	m := map[string]interface{}{
+		"forward_when_query_arg_profile_is_unknown": aws.BoolValue(queryArgProfileConfig.ForwardWhenQueryArgProfileIsUnknown),
+		"query_arg_profiles":                        profiles,
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFrontFieldLevelEncryptionConfig_basic(t *testing.T) {
+	var config cloudfront.FieldLevelEncryption
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_field_level_encryption_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFrontFieldLevelEncryptionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionConfigConfig_Forward(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFrontFieldLevelEncryptionConfigExists(resourceName, &config),
+					resource.TestMatchResourceAttr(resourceName, "caller_reference", regexp.MustCompile("^20[0-9]{2}.*")),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "content_type_profile_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content_type_profile_config.0.content_type_profiles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content_type_profile_config.0.forward_when_content_type_is_unknown", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.0.forward_when_query_arg_profile_is_unknown", "false"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.0.query_arg_profiles.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionConfigConfig_Forward(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFrontFieldLevelEncryptionConfigExists(resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "content_type_profile_config.0.forward_when_content_type_is_unknown", "true"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.0.forward_when_query_arg_profile_is_unknown", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudFrontFieldLevelEncryptionConfigDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_field_level_encryption_config" {
+			continue
+		}
+
+		input := &cloudfront.GetFieldLevelEncryptionInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.GetFieldLevelEncryption(input)
+
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionConfig, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Field Level Encryption Config %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudFrontFieldLevelEncryptionConfigExists(resourceName string, config *cloudfront.FieldLevelEncryption) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Field Level Encryption Config ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		input := &cloudfront.GetFieldLevelEncryptionInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetFieldLevelEncryption(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.FieldLevelEncryption == nil {
+			return fmt.Errorf("CloudFront Field Level Encryption Config %q does not exist", rs.Primary.ID)
+		}
+
+		*config = *output.FieldLevelEncryption
+
+		return nil
+	}
+}
+
+func testAccAWSCloudFrontFieldLevelEncryptionConfigConfig_Forward(rName string, forward bool) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  encoded_key = "${file("test-fixtures/cloudfront-public-key.pem")}"
+  name        = %[1]q
+}
+
+resource "aws_cloudfront_field_level_encryption_profile" "test" {
+  name = %[1]q
+
+  encryption_entities {
+    field_patterns = ["DateOfBirth"]
+    provider_id    = %[1]q
+    public_key_id  = "${aws_cloudfront_public_key.test.id}"
+  }
+}
+
+resource "aws_cloudfront_field_level_encryption_config" "test" {
+  comment = "some comment"
+
+  content_type_profile_config {
+    forward_when_content_type_is_unknown = %[2]t
+
+    content_type_profiles {
+      content_type = "application/x-www-form-urlencoded"
+      format       = "URLEncoded"
+    }
+  }
+
+  query_arg_profile_config {
+    forward_when_query_arg_profile_is_unknown = %[2]t
+
+    query_arg_profiles {
+      profile_id = "${aws_cloudfront_field_level_encryption_profile.test.id}"
+      query_arg  = "Arg1"
+    }
+  }
+}
+`, rName, forward)
+}
@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontFieldLevelEncryptionProfileCreate,
+		Read:   resourceAwsCloudFrontFieldLevelEncryptionProfileRead,
+		Update: resourceAwsCloudFrontFieldLevelEncryptionProfileUpdate,
+		Delete: resourceAwsCloudFrontFieldLevelEncryptionProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"caller_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"encryption_entities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_patterns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"provider_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"public_key_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.CreateFieldLevelEncryptionProfileInput{
+		FieldLevelEncryptionProfileConfig: expandCloudFrontFieldLevelEncryptionProfileConfig(d, time.Now().Format(time.RFC3339Nano)),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Field Level Encryption Profile: %s", input)
+	output, err := conn.CreateFieldLevelEncryptionProfile(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Field Level Encryption Profile: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.FieldLevelEncryptionProfile.Id))
+
+	return resourceAwsCloudFrontFieldLevelEncryptionProfileRead(d, meta)
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.GetFieldLevelEncryptionProfileInput{
+		Id: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetFieldLevelEncryptionProfile(input)
+	if err != nil {
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionProfile, "") {
+			log.Printf("[WARN] CloudFront Field Level Encryption Profile %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading CloudFront Field Level Encryption Profile (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.FieldLevelEncryptionProfile == nil || output.FieldLevelEncryptionProfile.FieldLevelEncryptionProfileConfig == nil {
+		log.Printf("[WARN] CloudFront Field Level Encryption Profile %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	profileConfig := output.FieldLevelEncryptionProfile.FieldLevelEncryptionProfileConfig
+
+	d.Set("caller_reference", profileConfig.CallerReference)
+	d.Set("comment", profileConfig.Comment)
+
+	if err := d.Set("encryption_entities", flattenCloudFrontEncryptionEntities(profileConfig.EncryptionEntities)); err != nil {
+		return fmt.Errorf("error setting encryption_entities: %s", err)
+	}
+
+	d.Set("etag", output.ETag)
+	d.Set("name", profileConfig.Name)
+
+	return nil
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdateFieldLevelEncryptionProfileInput{
+		FieldLevelEncryptionProfileConfig: expandCloudFrontFieldLevelEncryptionProfileConfig(d, d.Get("caller_reference").(string)),
+		Id:                                aws.String(d.Id()),
+		IfMatch:                           aws.String(d.Get("etag").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Field Level Encryption Profile: %s", input)
+	_, err := conn.UpdateFieldLevelEncryptionProfile(input)
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Field Level Encryption Profile (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontFieldLevelEncryptionProfileRead(d, meta)
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.DeleteFieldLevelEncryptionProfileInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFront Field Level Encryption Profile: %s", input)
+	_, err := conn.DeleteFieldLevelEncryptionProfile(input)
+	if err != nil {
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionProfile, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting CloudFront Field Level Encryption Profile (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontFieldLevelEncryptionProfileConfig(d *schema.ResourceData, callerReference string) *cloudfront.FieldLevelEncryptionProfileConfig {
+	profileConfig := &cloudfront.FieldLevelEncryptionProfileConfig{
+		CallerReference:    aws.String(callerReference),
+		EncryptionEntities: expandCloudFrontEncryptionEntities(d.Get("encryption_entities").(*schema.Set).List()),
+		Name:               aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		profileConfig.Comment = aws.String(v.(string))
+	}
+
+	return profileConfig
+}
+
+func expandCloudFrontEncryptionEntities(l []interface{}) *cloudfront.EncryptionEntities {
+	items := make([]*cloudfront.EncryptionEntity, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		fieldPatterns := expandStringSet(m["field_patterns"].(*schema.Set))
+
+		items = append(items, &cloudfront.EncryptionEntity{
+			FieldPatterns: &cloudfront.FieldPatterns{
+				Items:    fieldPatterns,
+				Quantity: aws.Int64(int64(len(fieldPatterns))),
+			},
+			ProviderId:  aws.String(m["provider_id"].(string)),
+			PublicKeyId: aws.String(m["public_key_id"].(string)),
+		})
+	}
+
+	return &cloudfront.EncryptionEntities{
+		Items:    items,
+		Quantity: aws.Int64(int64(len(items))),
+	}
+}
+
+func flattenCloudFrontEncryptionEntities(encryptionEntities *cloudfront.EncryptionEntities) []interface{} {
+	if encryptionEntities == nil {
+		return []interface{}{}
+	}
+
+	l := make([]interface{}, 0, len(encryptionEntities.Items))
+
+	for _, encryptionEntity := range encryptionEntities.Items {
+		if encryptionEntity == nil {
+			continue
+		}
+
+		var fieldPatterns []*string
+		if encryptionEntity.FieldPatterns != nil {
+			fieldPatterns = encryptionEntity.FieldPatterns.Items
+		}
+
+		m := map[string]interface{}{
+			"field_patterns": schema.NewSet(schema.HashString, flattenStringList(fieldPatterns)),
+			"provider_id":    aws.StringValue(encryptionEntity.ProviderId),
+			"public_key_id":  aws.StringValue(encryptionEntity.PublicKeyId),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
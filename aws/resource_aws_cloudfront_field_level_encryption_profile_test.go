@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFrontFieldLevelEncryptionProfile_basic(t *testing.T) {
+	var profile cloudfront.FieldLevelEncryptionProfile
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_field_level_encryption_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFrontFieldLevelEncryptionProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionProfileConfig_FieldPatterns(rName, `"DateOfBirth"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFrontFieldLevelEncryptionProfileExists(resourceName, &profile),
+					resource.TestMatchResourceAttr(resourceName, "caller_reference", regexp.MustCompile("^20[0-9]{2}.*")),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_entities.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionProfileConfig_FieldPatterns(rName, `"DateOfBirth", "FirstName"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFrontFieldLevelEncryptionProfileExists(resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, "encryption_entities.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudFrontFieldLevelEncryptionProfileDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_field_level_encryption_profile" {
+			continue
+		}
+
+		input := &cloudfront.GetFieldLevelEncryptionProfileInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.GetFieldLevelEncryptionProfile(input)
+
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionProfile, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Field Level Encryption Profile %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudFrontFieldLevelEncryptionProfileExists(resourceName string, profile *cloudfront.FieldLevelEncryptionProfile) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Field Level Encryption Profile ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		input := &cloudfront.GetFieldLevelEncryptionProfileInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetFieldLevelEncryptionProfile(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.FieldLevelEncryptionProfile == nil {
+			return fmt.Errorf("CloudFront Field Level Encryption Profile %q does not exist", rs.Primary.ID)
+		}
+
+		*profile = *output.FieldLevelEncryptionProfile
+
+		return nil
+	}
+}
+
+func testAccAWSCloudFrontFieldLevelEncryptionProfileConfig_FieldPatterns(rName, fieldPatterns string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  encoded_key = "${file("test-fixtures/cloudfront-public-key.pem")}"
+  name        = %[1]q
+}
+
+resource "aws_cloudfront_field_level_encryption_profile" "test" {
+  comment = "some comment"
+  name    = %[1]q
+
+  encryption_entities {
+    field_patterns = [%[2]s]
+    provider_id    = %[1]q
+    public_key_id  = "${aws_cloudfront_public_key.test.id}"
+  }
+}
+`, rName, fieldPatterns)
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCloudFrontPublicKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontPublicKeyCreate,
+		Read:   resourceAwsCloudFrontPublicKeyRead,
+		Update: resourceAwsCloudFrontPublicKeyUpdate,
+		Delete: resourceAwsCloudFrontPublicKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"caller_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"encoded_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontPublicKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.CreatePublicKeyInput{
+		PublicKeyConfig: &cloudfront.PublicKeyConfig{
+			CallerReference: aws.String(time.Now().Format(time.RFC3339Nano)),
+			EncodedKey:      aws.String(d.Get("encoded_key").(string)),
+			Name:            aws.String(d.Get("name").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.PublicKeyConfig.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Public Key: %s", input)
+	output, err := conn.CreatePublicKey(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Public Key: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PublicKey.Id))
+
+	return resourceAwsCloudFrontPublicKeyRead(d, meta)
+}
+
+func resourceAwsCloudFrontPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.GetPublicKeyInput{
+		Id: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetPublicKey(input)
+	if err != nil {
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchPublicKey, "") {
+			log.Printf("[WARN] CloudFront Public Key %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading CloudFront Public Key (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.PublicKey == nil || output.PublicKey.PublicKeyConfig == nil {
+		log.Printf("[WARN] CloudFront Public Key %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	publicKeyConfig := output.PublicKey.PublicKeyConfig
+
+	d.Set("caller_reference", publicKeyConfig.CallerReference)
+	d.Set("comment", publicKeyConfig.Comment)
+	d.Set("encoded_key", publicKeyConfig.EncodedKey)
+	d.Set("etag", output.ETag)
+	d.Set("name", publicKeyConfig.Name)
+
+	return nil
+}
+
+func resourceAwsCloudFrontPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdatePublicKeyInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+		PublicKeyConfig: &cloudfront.PublicKeyConfig{
+			CallerReference: aws.String(d.Get("caller_reference").(string)),
+			EncodedKey:      aws.String(d.Get("encoded_key").(string)),
+			Name:            aws.String(d.Get("name").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.PublicKeyConfig.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Public Key: %s", input)
+	_, err := conn.UpdatePublicKey(input)
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Public Key (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontPublicKeyRead(d, meta)
+}
+
+func resourceAwsCloudFrontPublicKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.DeletePublicKeyInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFront Public Key: %s", input)
+	_, err := conn.DeletePublicKey(input)
+	if err != nil {
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchPublicKey, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting CloudFront Public Key (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudFrontPublicKey_basic(t *testing.T) {
+	var publicKey cloudfront.PublicKey
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_public_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFrontPublicKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontPublicKeyConfig_Comment(rName, "comment1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFrontPublicKeyExists(resourceName, &publicKey),
+					resource.TestMatchResourceAttr(resourceName, "caller_reference", regexp.MustCompile("^20[0-9]{2}.*")),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment1"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontPublicKeyConfig_Comment(rName, "comment2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudFrontPublicKeyExists(resourceName, &publicKey),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudFrontPublicKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_public_key" {
+			continue
+		}
+
+		input := &cloudfront.GetPublicKeyInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.GetPublicKey(input)
+
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchPublicKey, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Public Key %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudFrontPublicKeyExists(resourceName string, publicKey *cloudfront.PublicKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Public Key ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		input := &cloudfront.GetPublicKeyInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetPublicKey(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.PublicKey == nil {
+			return fmt.Errorf("CloudFront Public Key %q does not exist", rs.Primary.ID)
+		}
+
+		*publicKey = *output.PublicKey
+
+		return nil
+	}
+}
+
+func testAccAWSCloudFrontPublicKeyConfig_Comment(rName, comment string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  comment     = %q
+  encoded_key = "${file("test-fixtures/cloudfront-public-key.pem")}"
+  name        = %q
+}
+`, comment, rName)
+}
@@ -34,8 +34,9 @@ func resourceAwsCloudWatchLogDestination() *schema.Resource {
 			},
 
 			"role_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"target_arn": {
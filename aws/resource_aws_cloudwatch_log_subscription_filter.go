@@ -44,9 +44,10 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 				ForceNew: true,
 			},
 			"role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 			"distribution": {
 				Type:     schema.TypeString,
@@ -183,6 +183,121 @@ func resourceAwsCodeBuildProject() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateAwsCodeBuildProjectName,
 			},
+			"secondary_artifacts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 12,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"artifact_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"encryption_disabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"namespace_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								codebuild.ArtifactNamespaceNone,
+								codebuild.ArtifactNamespaceBuildId,
+							}, false),
+						},
+						"packaging": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								codebuild.ArtifactsTypeS3,
+							}, false),
+						},
+					},
+				},
+			},
+			"secondary_sources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 12,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth": {
+							Type: schema.TypeSet,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource": {
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Optional:  true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											codebuild.SourceAuthTypeOauth,
+										}, false),
+									},
+								},
+							},
+							Optional: true,
+							Set:      resourceAwsCodeBuildProjectSourceAuthHash,
+						},
+						"buildspec": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"git_clone_depth": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"insecure_ssl": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"report_build_status": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"source_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								codebuild.SourceTypeCodecommit,
+								codebuild.SourceTypeGithub,
+								codebuild.SourceTypeS3,
+								codebuild.SourceTypeBitbucket,
+								codebuild.SourceTypeGithubEnterprise,
+							}, false),
+						},
+					},
+				},
+			},
 			"service_role": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -336,6 +451,14 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.Cache = expandProjectCache(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("secondary_artifacts"); ok {
+		params.SecondaryArtifacts = expandProjectSecondaryArtifacts(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("secondary_sources"); ok {
+		params.SecondarySources = expandProjectSecondarySources(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		params.Description = aws.String(v.(string))
 	}
@@ -360,7 +483,7 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.BadgeEnabled = aws.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.Tags = tagsFromMapCodeBuild(v.(map[string]interface{}))
 	}
 
@@ -397,6 +520,25 @@ func expandProjectArtifacts(d *schema.ResourceData) codebuild.ProjectArtifacts {
 	configs := d.Get("artifacts").(*schema.Set).List()
 	data := configs[0].(map[string]interface{})
 
+	return expandProjectArtifactData(data)
+}
+
+func expandProjectSecondaryArtifacts(configs []interface{}) []*codebuild.ProjectArtifacts {
+	artifacts := make([]*codebuild.ProjectArtifacts, 0, len(configs))
+
+	for _, config := range configs {
+		data := config.(map[string]interface{})
+
+		projectArtifacts := expandProjectArtifactData(data)
+		projectArtifacts.ArtifactIdentifier = aws.String(data["artifact_identifier"].(string))
+
+		artifacts = append(artifacts, &projectArtifacts)
+	}
+
+	return artifacts
+}
+
+func expandProjectArtifactData(data map[string]interface{}) codebuild.ProjectArtifacts {
 	artifactType := data["type"].(string)
 
 	projectArtifacts := codebuild.ProjectArtifacts{
@@ -521,31 +663,51 @@ func expandProjectSource(d *schema.ResourceData) codebuild.ProjectSource {
 
 	for _, configRaw := range configs {
 		data := configRaw.(map[string]interface{})
+		projectSource = expandProjectSourceData(data)
+	}
 
-		sourceType := data["type"].(string)
+	return projectSource
+}
 
-		projectSource = codebuild.ProjectSource{
-			Buildspec:     aws.String(data["buildspec"].(string)),
-			GitCloneDepth: aws.Int64(int64(data["git_clone_depth"].(int))),
-			InsecureSsl:   aws.Bool(data["insecure_ssl"].(bool)),
-			Location:      aws.String(data["location"].(string)),
-			Type:          aws.String(sourceType),
-		}
+func expandProjectSecondarySources(configs []interface{}) []*codebuild.ProjectSource {
+	sources := make([]*codebuild.ProjectSource, 0, len(configs))
 
-		// Only valid for GITHUB source type, e.g.
-		// InvalidInputException: Source type GITHUB_ENTERPRISE does not support ReportBuildStatus
-		if sourceType == codebuild.SourceTypeGithub {
-			projectSource.ReportBuildStatus = aws.Bool(data["report_build_status"].(bool))
-		}
+	for _, config := range configs {
+		data := config.(map[string]interface{})
 
-		if v, ok := data["auth"]; ok {
-			if len(v.(*schema.Set).List()) > 0 {
-				auth := v.(*schema.Set).List()[0].(map[string]interface{})
+		projectSource := expandProjectSourceData(data)
+		projectSource.SourceIdentifier = aws.String(data["source_identifier"].(string))
 
-				projectSource.Auth = &codebuild.SourceAuth{
-					Type:     aws.String(auth["type"].(string)),
-					Resource: aws.String(auth["resource"].(string)),
-				}
+		sources = append(sources, &projectSource)
+	}
+
+	return sources
+}
+
+func expandProjectSourceData(data map[string]interface{}) codebuild.ProjectSource {
+	sourceType := data["type"].(string)
+
+	projectSource := codebuild.ProjectSource{
+		Buildspec:     aws.String(data["buildspec"].(string)),
+		GitCloneDepth: aws.Int64(int64(data["git_clone_depth"].(int))),
+		InsecureSsl:   aws.Bool(data["insecure_ssl"].(bool)),
+		Location:      aws.String(data["location"].(string)),
+		Type:          aws.String(sourceType),
+	}
+
+	// Only valid for GITHUB source type, e.g.
+	// InvalidInputException: Source type GITHUB_ENTERPRISE does not support ReportBuildStatus
+	if sourceType == codebuild.SourceTypeGithub {
+		projectSource.ReportBuildStatus = aws.Bool(data["report_build_status"].(bool))
+	}
+
+	if v, ok := data["auth"]; ok {
+		if len(v.(*schema.Set).List()) > 0 {
+			auth := v.(*schema.Set).List()[0].(map[string]interface{})
+
+			projectSource.Auth = &codebuild.SourceAuth{
+				Type:     aws.String(auth["type"].(string)),
+				Resource: aws.String(auth["resource"].(string)),
 			}
 		}
 	}
@@ -591,6 +753,14 @@ func resourceAwsCodeBuildProjectRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if err := d.Set("secondary_artifacts", flattenAwsCodeBuildProjectSecondaryArtifacts(project.SecondaryArtifacts)); err != nil {
+		return fmt.Errorf("error setting secondary_artifacts: %s", err)
+	}
+
+	if err := d.Set("secondary_sources", flattenAwsCodeBuildProjectSecondarySources(project.SecondarySources)); err != nil {
+		return fmt.Errorf("error setting secondary_sources: %s", err)
+	}
+
 	if err := d.Set("vpc_config", flattenAwsCodeBuildVpcConfig(project.VpcConfig)); err != nil {
 		return err
 	}
@@ -637,6 +807,14 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 		params.Artifacts = &projectArtifacts
 	}
 
+	if d.HasChange("secondary_artifacts") {
+		params.SecondaryArtifacts = expandProjectSecondaryArtifacts(d.Get("secondary_artifacts").(*schema.Set).List())
+	}
+
+	if d.HasChange("secondary_sources") {
+		params.SecondarySources = expandProjectSecondarySources(d.Get("secondary_sources").(*schema.Set).List())
+	}
+
 	if d.HasChange("vpc_config") {
 		params.VpcConfig = expandCodeBuildVpcConfig(d.Get("vpc_config").([]interface{}))
 	}
@@ -673,7 +851,7 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 
 	// The documentation clearly says "The replacement set of tags for this build project."
 	// But its a slice of pointers so if not set for every update, they get removed.
-	params.Tags = tagsFromMapCodeBuild(d.Get("tags").(map[string]interface{}))
+	params.Tags = tagsFromMapCodeBuild(d.Get("tags_all").(map[string]interface{}))
 
 	// Handle IAM eventual consistency
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -804,6 +982,37 @@ func flattenAwsCodeBuildProjectSource(source *codebuild.ProjectSource) []interfa
 	return l
 }
 
+func flattenAwsCodeBuildProjectSecondaryArtifacts(artifactsList []*codebuild.ProjectArtifacts) []interface{} {
+	artifacts := make([]interface{}, 0, len(artifactsList))
+
+	for _, a := range artifactsList {
+		artifacts = append(artifacts, map[string]interface{}{
+			"artifact_identifier": aws.StringValue(a.ArtifactIdentifier),
+			"encryption_disabled": aws.BoolValue(a.EncryptionDisabled),
+			"location":            aws.StringValue(a.Location),
+			"name":                aws.StringValue(a.Name),
+			"namespace_type":      aws.StringValue(a.NamespaceType),
+			"packaging":           aws.StringValue(a.Packaging),
+			"path":                aws.StringValue(a.Path),
+			"type":                aws.StringValue(a.Type),
+		})
+	}
+
+	return artifacts
+}
+
+func flattenAwsCodeBuildProjectSecondarySources(sourceList []*codebuild.ProjectSource) []interface{} {
+	sources := make([]interface{}, 0, len(sourceList))
+
+	for _, source := range sourceList {
+		m := flattenAwsCodeBuildProjectSource(source)[0].(map[string]interface{})
+		m["source_identifier"] = aws.StringValue(source.SourceIdentifier)
+		sources = append(sources, m)
+	}
+
+	return sources
+}
+
 func flattenAwsCodeBuildVpcConfig(vpcConfig *codebuild.VpcConfig) []interface{} {
 	if vpcConfig != nil {
 		values := map[string]interface{}{}
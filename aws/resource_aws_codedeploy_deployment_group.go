@@ -140,8 +140,9 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 			},
 
 			"service_role_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"alarm_configuration": {
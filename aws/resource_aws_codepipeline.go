@@ -37,8 +37,9 @@ func resourceAwsCodePipeline() *schema.Resource {
 			},
 
 			"role_arn": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"artifact_store": {
@@ -147,8 +148,9 @@ func resourceAwsCodePipeline() *schema.Resource {
 										Required: true,
 									},
 									"role_arn": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateIamRoleArn,
 									},
 									"run_order": {
 										Type:     schema.TypeInt,
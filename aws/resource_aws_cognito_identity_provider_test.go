@@ -42,6 +42,17 @@ func TestAccAWSCognitoIdentityProvider_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccAWSCognitoIdentityProviderConfig_updated(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoIdentityProviderExists(resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.name", "name"),
+					resource.TestCheckResourceAttr(resourceName, "idp_identifiers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "idp_identifiers.0", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.authorize_scopes", "email profile"),
+				),
+			},
 		},
 	})
 }
@@ -142,3 +153,38 @@ resource "aws_cognito_identity_provider" "test" {
 }
 `
 }
+
+func testAccAWSCognitoIdentityProviderConfig_updated() string {
+	return `
+
+resource "aws_cognito_user_pool" "test" {
+  name                     = "tfmytestpool"
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "test" {
+  user_pool_id    = "${aws_cognito_user_pool.test.id}"
+  provider_name   = "Google"
+  provider_type   = "Google"
+  idp_identifiers = ["example.com"]
+
+  provider_details {
+    attributes_url                = "https://people.googleapis.com/v1/people/me?personFields="
+    attributes_url_add_attributes = "true"
+    authorize_scopes              = "email profile"
+    authorize_url                 = "https://accounts.google.com/o/oauth2/v2/auth"
+    client_id                     = "test-url.apps.googleusercontent.com"
+    client_secret                 = "client_secret"
+    oidc_issuer                   = "https://accounts.google.com"
+    token_request_method          = "POST"
+    token_url                     = "https://www.googleapis.com/oauth2/v4/token"
+  }
+
+  attribute_mapping {
+    email    = "email"
+    name     = "name"
+    username = "sub"
+  }
+}
+`
+}
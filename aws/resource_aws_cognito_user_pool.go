@@ -397,8 +397,41 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				ValidateFunc: validateCognitoUserPoolSmsVerificationMessage,
 			},
 
+			"software_token_mfa_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 
+			"user_pool_add_ons": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advanced_security_mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cognitoidentityprovider.AdvancedSecurityModeTypeAudit,
+								cognitoidentityprovider.AdvancedSecurityModeTypeEnforced,
+								cognitoidentityprovider.AdvancedSecurityModeTypeOff,
+							}, false),
+						},
+					},
+				},
+			},
+
 			"username_attributes": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -544,8 +577,12 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if v, ok := d.GetOk("mfa_configuration"); ok {
-		params.MfaConfiguration = aws.String(v.(string))
+	// Software token MFA can only be configured through SetUserPoolMfaConfig,
+	// and CreateUserPool rejects MFA without an SMS configuration.
+	if _, ok := d.GetOk("software_token_mfa_configuration"); !ok {
+		if v, ok := d.GetOk("mfa_configuration"); ok {
+			params.MfaConfiguration = aws.String(v.(string))
+		}
 	}
 
 	if v, ok := d.GetOk("password_policy"); ok {
@@ -577,6 +614,10 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if v, ok := d.GetOk("user_pool_add_ons"); ok {
+		params.UserPoolAddOns = expandCognitoUserPoolAddOns(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("username_attributes"); ok {
 		params.UsernameAttributes = expandStringList(v.([]interface{}))
 	}
@@ -594,7 +635,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
@@ -622,6 +663,12 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*resp.UserPool.Id)
 
+	if _, ok := d.GetOk("software_token_mfa_configuration"); ok {
+		if err := setCognitoUserPoolMfaConfig(conn, d); err != nil {
+			return fmt.Errorf("error setting Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsCognitoUserPoolRead(d, meta)
 }
 
@@ -659,7 +706,8 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 		Resource:  fmt.Sprintf("userpool/%s", d.Id()),
 	}
 	d.Set("arn", arn.String())
-	d.Set("endpoint", fmt.Sprintf("cognito-idp.%s.amazonaws.com/%s", meta.(*AWSClient).region, d.Id()))
+	region := meta.(*AWSClient).region
+	d.Set("endpoint", fmt.Sprintf("cognito-idp.%s.%s/%s", region, partitionDnsSuffix(region), d.Id()))
 	d.Set("auto_verified_attributes", flattenStringList(resp.UserPool.AutoVerifiedAttributes))
 
 	if resp.UserPool.EmailVerificationSubject != nil {
@@ -711,10 +759,37 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("username_attributes", flattenStringList(resp.UserPool.UsernameAttributes))
 	}
 
+	// Removing user_pool_add_ons turns advanced security OFF, which the API still reports.
+	userPoolAddOns := resp.UserPool.UserPoolAddOns
+	if _, ok := d.GetOk("user_pool_add_ons"); !ok && userPoolAddOns != nil && aws.StringValue(userPoolAddOns.AdvancedSecurityMode) == cognitoidentityprovider.AdvancedSecurityModeTypeOff {
+		userPoolAddOns = nil
+	}
+
+	if err := d.Set("user_pool_add_ons", flattenCognitoUserPoolAddOns(userPoolAddOns)); err != nil {
+		return fmt.Errorf("Failed setting user_pool_add_ons: %s", err)
+	}
+
 	if err := d.Set("verification_message_template", flattenCognitoUserPoolVerificationMessageTemplate(resp.UserPool.VerificationMessageTemplate)); err != nil {
 		return fmt.Errorf("Failed setting verification_message_template: %s", err)
 	}
 
+	mfaResp, err := conn.GetUserPoolMfaConfig(&cognitoidentityprovider.GetUserPoolMfaConfigInput{
+		UserPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
+	}
+
+	// Removing software_token_mfa_configuration disables it, which the API may still report.
+	softwareTokenMfaConfiguration := mfaResp.SoftwareTokenMfaConfiguration
+	if _, ok := d.GetOk("software_token_mfa_configuration"); !ok && softwareTokenMfaConfiguration != nil && !aws.BoolValue(softwareTokenMfaConfiguration.Enabled) {
+		softwareTokenMfaConfiguration = nil
+	}
+
+	if err := d.Set("software_token_mfa_configuration", flattenCognitoUserPoolSoftwareTokenMfaConfiguration(softwareTokenMfaConfiguration)); err != nil {
+		return fmt.Errorf("Failed setting software_token_mfa_configuration: %s", err)
+	}
+
 	d.Set("creation_date", resp.UserPool.CreationDate.Format(time.RFC3339))
 	d.Set("last_modified_date", resp.UserPool.LastModifiedDate.Format(time.RFC3339))
 	d.Set("name", resp.UserPool.Name)
@@ -726,6 +801,14 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cognitoidpconn
 
+	// Software token MFA must be enabled before UpdateUserPool will accept
+	// an MFA configuration without SMS.
+	if d.HasChange("software_token_mfa_configuration") || (d.HasChange("mfa_configuration") && len(d.Get("software_token_mfa_configuration").([]interface{})) > 0) {
+		if err := setCognitoUserPoolMfaConfig(conn, d); err != nil {
+			return fmt.Errorf("error setting Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
+		}
+	}
+
 	params := &cognitoidentityprovider.UpdateUserPoolInput{
 		UserPoolId: aws.String(d.Id()),
 	}
@@ -816,6 +899,14 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if v, ok := d.GetOk("user_pool_add_ons"); ok {
+		params.UserPoolAddOns = expandCognitoUserPoolAddOns(v.([]interface{}))
+	} else if d.HasChange("user_pool_add_ons") {
+		params.UserPoolAddOns = &cognitoidentityprovider.UserPoolAddOnsType{
+			AdvancedSecurityMode: aws.String(cognitoidentityprovider.AdvancedSecurityModeTypeOff),
+		}
+	}
+
 	if v, ok := d.GetOk("verification_message_template"); ok {
 		configs := v.([]interface{})
 		config, ok := configs[0].(map[string]interface{})
@@ -839,7 +930,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
@@ -885,3 +976,49 @@ func resourceAwsCognitoUserPoolDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+func setCognitoUserPoolMfaConfig(conn *cognitoidentityprovider.CognitoIdentityProvider, d *schema.ResourceData) error {
+	mfaConfiguration := d.Get("mfa_configuration").(string)
+
+	input := &cognitoidentityprovider.SetUserPoolMfaConfigInput{
+		MfaConfiguration:              aws.String(mfaConfiguration),
+		SoftwareTokenMfaConfiguration: expandCognitoUserPoolSoftwareTokenMfaConfiguration(d.Get("software_token_mfa_configuration").([]interface{})),
+		UserPoolId:                    aws.String(d.Id()),
+	}
+
+	// The API rejects an SMS MFA configuration while MFA is turned off.
+	if v, ok := d.GetOk("sms_configuration"); ok && mfaConfiguration != cognitoidentityprovider.UserPoolMfaTypeOff {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			input.SmsMfaConfiguration = &cognitoidentityprovider.SmsMfaConfigType{
+				SmsConfiguration: expandCognitoUserPoolSmsConfiguration(config),
+			}
+
+			if v, ok := d.GetOk("sms_authentication_message"); ok {
+				input.SmsMfaConfiguration.SmsAuthenticationMessage = aws.String(v.(string))
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Setting Cognito User Pool MFA configuration: %s", input)
+
+	// IAM roles & policies can take some time to propagate and be attached
+	// to the User Pool.
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.SetUserPoolMfaConfig(input)
+		if isAWSErr(err, "InvalidSmsRoleTrustRelationshipException", "Role does not have a trust relationship allowing Cognito to assume the role") {
+			log.Printf("[DEBUG] Received %s, retrying SetUserPoolMfaConfig", err)
+			return resource.RetryableError(err)
+		}
+		if isAWSErr(err, "InvalidSmsRoleAccessPolicyException", "Role does not have permission to publish with SNS") {
+			log.Printf("[DEBUG] Received %s, retrying SetUserPoolMfaConfig", err)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
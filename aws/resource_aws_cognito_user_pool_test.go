@@ -159,6 +159,87 @@ func TestAccAWSCognitoUserPool_withDeviceConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoUserPool_withSoftwareTokenMfaConfiguration(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, "ON"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "ON"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, "OPTIONAL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OPTIONAL"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfigurationDisabled(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OFF"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.0.enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "mfa_configuration", "OFF"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "software_token_mfa_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withUserPoolAddOns(t *testing.T) {
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withUserPoolAddOns(name, "AUDIT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists("aws_cognito_user_pool.pool"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "user_pool_add_ons.#", "1"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "user_pool_add_ons.0.advanced_security_mode", "AUDIT"),
+				),
+			},
+			{
+				ResourceName:      "aws_cognito_user_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withUserPoolAddOns(name, "ENFORCED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "user_pool_add_ons.0.advanced_security_mode", "ENFORCED"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolAdvancedSecurityMode("aws_cognito_user_pool.pool", "OFF"),
+					resource.TestCheckResourceAttr("aws_cognito_user_pool.pool", "user_pool_add_ons.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSCognitoUserPool_withEmailVerificationMessage(t *testing.T) {
 	name := acctest.RandString(5)
 	subject := acctest.RandString(10)
@@ -676,6 +757,35 @@ func testAccCheckAWSCognitoUserPoolExists(name string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAWSCognitoUserPoolAdvancedSecurityMode(name, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		resp, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+			UserPoolId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		var actual string
+		if resp.UserPool.UserPoolAddOns != nil {
+			actual = aws.StringValue(resp.UserPool.UserPoolAddOns.AdvancedSecurityMode)
+		}
+
+		if actual != expected {
+			return fmt.Errorf("expected Cognito User Pool (%s) advanced security mode %q, got %q", rs.Primary.ID, expected, actual)
+		}
+
+		return nil
+	}
+}
+
 func testAccAWSCognitoUserPoolConfig_basic(name string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "pool" {
@@ -743,6 +853,41 @@ resource "aws_cognito_user_pool" "pool" {
 }`, name)
 }
 
+func testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfiguration(name, mfaConfiguration string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name              = "terraform-test-pool-%s"
+  mfa_configuration = "%s"
+
+  software_token_mfa_configuration {
+    enabled = true
+  }
+}`, name, mfaConfiguration)
+}
+
+func testAccAWSCognitoUserPoolConfig_withSoftwareTokenMfaConfigurationDisabled(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name              = "terraform-test-pool-%s"
+  mfa_configuration = "OFF"
+
+  software_token_mfa_configuration {
+    enabled = false
+  }
+}`, name)
+}
+
+func testAccAWSCognitoUserPoolConfig_withUserPoolAddOns(name, advancedSecurityMode string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "pool" {
+  name = "terraform-test-pool-%s"
+
+  user_pool_add_ons {
+    advanced_security_mode = "%s"
+  }
+}`, name, advancedSecurityMode)
+}
+
 func testAccAWSCognitoUserPoolConfig_withEmailVerificationMessage(name, subject, message string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "pool" {
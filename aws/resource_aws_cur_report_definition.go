@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCurReportDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCurReportDefinitionCreate,
+		Read:   resourceAwsCurReportDefinitionRead,
+		Delete: resourceAwsCurReportDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// The API has no operation to modify a report definition, so every
+		// argument forces a new resource.
+		Schema: map[string]*schema.Schema{
+			"report_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z!\-_.*\'()]{1,256}$`), "must be 1 to 256 alphanumeric characters or the following special characters: !-_.*'()"),
+			},
+			"time_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					costandusagereportservice.TimeUnitDaily,
+					costandusagereportservice.TimeUnitHourly,
+				}, false),
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					costandusagereportservice.ReportFormatTextOrcsv,
+				}, false),
+			},
+			"compression": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					costandusagereportservice.CompressionFormatGzip,
+					costandusagereportservice.CompressionFormatZip,
+				}, false),
+			},
+			"additional_schema_elements": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						costandusagereportservice.SchemaElementResources,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"s3_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"s3_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"s3_region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					costandusagereportservice.AWSRegionApNortheast1,
+					costandusagereportservice.AWSRegionApSoutheast1,
+					costandusagereportservice.AWSRegionApSoutheast2,
+					costandusagereportservice.AWSRegionEuCentral1,
+					costandusagereportservice.AWSRegionEuWest1,
+					costandusagereportservice.AWSRegionUsEast1,
+					costandusagereportservice.AWSRegionUsWest1,
+					costandusagereportservice.AWSRegionUsWest2,
+				}, false),
+			},
+			"additional_artifacts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						costandusagereportservice.AdditionalArtifactQuicksight,
+						costandusagereportservice.AdditionalArtifactRedshift,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsCurReportDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).curconn
+
+	reportName := d.Get("report_name").(string)
+
+	input := &costandusagereportservice.PutReportDefinitionInput{
+		ReportDefinition: &costandusagereportservice.ReportDefinition{
+			AdditionalSchemaElements: expandStringSet(d.Get("additional_schema_elements").(*schema.Set)),
+			Compression:              aws.String(d.Get("compression").(string)),
+			Format:                   aws.String(d.Get("format").(string)),
+			ReportName:               aws.String(reportName),
+			S3Bucket:                 aws.String(d.Get("s3_bucket").(string)),
+			S3Prefix:                 aws.String(d.Get("s3_prefix").(string)),
+			S3Region:                 aws.String(d.Get("s3_region").(string)),
+			TimeUnit:                 aws.String(d.Get("time_unit").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("additional_artifacts"); ok && v.(*schema.Set).Len() > 0 {
+		input.ReportDefinition.AdditionalArtifacts = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating Cost and Usage Report Definition: %s", input)
+	if _, err := conn.PutReportDefinition(input); err != nil {
+		return fmt.Errorf("error creating Cost and Usage Report Definition (%s): %s", reportName, err)
+	}
+
+	d.SetId(reportName)
+
+	return resourceAwsCurReportDefinitionRead(d, meta)
+}
+
+func resourceAwsCurReportDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).curconn
+
+	reportDefinition, err := describeCurReportDefinition(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Cost and Usage Report Definition (%s): %s", d.Id(), err)
+	}
+
+	if reportDefinition == nil {
+		log.Printf("[WARN] Cost and Usage Report Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("compression", reportDefinition.Compression)
+	d.Set("format", reportDefinition.Format)
+	d.Set("report_name", reportDefinition.ReportName)
+	d.Set("s3_bucket", reportDefinition.S3Bucket)
+	d.Set("s3_prefix", reportDefinition.S3Prefix)
+	d.Set("s3_region", reportDefinition.S3Region)
+	d.Set("time_unit", reportDefinition.TimeUnit)
+
+	if err := d.Set("additional_artifacts", aws.StringValueSlice(reportDefinition.AdditionalArtifacts)); err != nil {
+		return fmt.Errorf("error setting additional_artifacts: %s", err)
+	}
+
+	if err := d.Set("additional_schema_elements", aws.StringValueSlice(reportDefinition.AdditionalSchemaElements)); err != nil {
+		return fmt.Errorf("error setting additional_schema_elements: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCurReportDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).curconn
+
+	input := &costandusagereportservice.DeleteReportDefinitionInput{
+		ReportName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Cost and Usage Report Definition: %s", input)
+	if _, err := conn.DeleteReportDefinition(input); err != nil {
+		return fmt.Errorf("error deleting Cost and Usage Report Definition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// describeCurReportDefinition returns the report definition with the given
+// name, or nil when no such report definition exists.
+func describeCurReportDefinition(conn *costandusagereportservice.CostandUsageReportService, reportName string) (*costandusagereportservice.ReportDefinition, error) {
+	var result *costandusagereportservice.ReportDefinition
+
+	input := &costandusagereportservice.DescribeReportDefinitionsInput{}
+	err := conn.DescribeReportDefinitionsPages(input, func(page *costandusagereportservice.DescribeReportDefinitionsOutput, lastPage bool) bool {
+		for _, reportDefinition := range page.ReportDefinitions {
+			if aws.StringValue(reportDefinition.ReportName) == reportName {
+				result = reportDefinition
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return result, err
+}
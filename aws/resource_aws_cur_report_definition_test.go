@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCurReportDefinition_basic(t *testing.T) {
+	resourceName := "aws_cur_report_definition.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCurReportDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCurReportDefinitionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCurReportDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "report_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "time_unit", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "format", "textORcsv"),
+					resource.TestCheckResourceAttr(resourceName, "compression", "GZIP"),
+					resource.TestCheckResourceAttr(resourceName, "additional_schema_elements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "s3_region", "us-east-1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_artifacts.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCurReportDefinitionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost and Usage Report Definition ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).curconn
+
+		reportDefinition, err := describeCurReportDefinition(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if reportDefinition == nil {
+			return fmt.Errorf("Cost and Usage Report Definition (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCurReportDefinitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).curconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cur_report_definition" {
+			continue
+		}
+
+		reportDefinition, err := describeCurReportDefinition(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if reportDefinition != nil {
+			return fmt.Errorf("Cost and Usage Report Definition (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCurReportDefinitionConfig(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "billingreports.amazonaws.com"
+      },
+      "Action": [
+        "s3:GetBucketAcl",
+        "s3:GetBucketPolicy"
+      ],
+      "Resource": "${aws_s3_bucket.test.arn}"
+    },
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "billingreports.amazonaws.com"
+      },
+      "Action": "s3:PutObject",
+      "Resource": "${aws_s3_bucket.test.arn}/*"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_cur_report_definition" "test" {
+  report_name                = %[1]q
+  time_unit                  = "DAILY"
+  format                     = "textORcsv"
+  compression                = "GZIP"
+  additional_schema_elements = ["RESOURCES"]
+  s3_bucket                  = "${aws_s3_bucket_policy.test.bucket}"
+  s3_region                  = "us-east-1"
+  additional_artifacts       = ["REDSHIFT", "QUICKSIGHT"]
+}
+`, rName)
+}
@@ -441,7 +441,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	// we expect everything to be in sync before returning completion.
 	var requiresRebootDbInstance bool
 
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
@@ -736,7 +736,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = waitForStateWithProgress(stateConf, fmt.Sprintf("DB Instance (%s) creation", d.Id()))
 		if err != nil {
 			return err
 		}
@@ -1062,7 +1062,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
-	_, err := stateConf.WaitForState()
+	_, err := waitForStateWithProgress(stateConf, fmt.Sprintf("DB Instance (%s) creation", d.Id()))
 	if err != nil {
 		return err
 	}
@@ -1279,7 +1279,7 @@ func waitUntilAwsDbInstanceIsAvailableAfterUpdate(id string, conn *rds.RDS, time
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
-	_, err := stateConf.WaitForState()
+	_, err := waitForStateWithProgress(stateConf, fmt.Sprintf("DB Instance (%s) modification", id))
 	return err
 }
 
@@ -1292,7 +1292,7 @@ func waitUntilAwsDbInstanceIsDeleted(id string, conn *rds.RDS, timeout time.Dura
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
-	_, err := stateConf.WaitForState()
+	_, err := waitForStateWithProgress(stateConf, fmt.Sprintf("DB Instance (%s) deletion", id))
 	return err
 }
 
@@ -1490,7 +1490,7 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
 			return err
 		} else {
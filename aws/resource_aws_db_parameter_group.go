@@ -81,6 +81,10 @@ func resourceAwsDbParameterGroup() *schema.Resource {
 				},
 				Set: resourceAwsDbParameterHash,
 			},
+			"reset_unmanaged_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 
 			"tags": tagsSchema(),
 		},
@@ -89,7 +93,7 @@ func resourceAwsDbParameterGroup() *schema.Resource {
 
 func resourceAwsDbParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -253,6 +257,12 @@ func resourceAwsDbParameterGroupUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(true)
 
+	if d.Get("reset_unmanaged_parameters").(bool) && (d.HasChange("parameter") || d.HasChange("reset_unmanaged_parameters")) {
+		if err := resetAwsDbParameterGroupUnmanagedParameters(rdsconn, d.Get("name").(string), d.Get("parameter").(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("parameter") {
 		o, n := d.GetChange("parameter")
 		if o == nil {
@@ -329,6 +339,67 @@ func resourceAwsDbParameterGroupDelete(d *schema.ResourceData, meta interface{})
 	})
 }
 
+// resetAwsDbParameterGroupUnmanagedParameters resets every user-modified
+// parameter in the group that isn't present in the configured set, so that
+// parameters changed outside of Terraform are returned to their defaults.
+func resetAwsDbParameterGroupUnmanagedParameters(conn *rds.RDS, name string, configured *schema.Set) error {
+	managed := make(map[string]bool)
+	for _, v := range configured.List() {
+		managed[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	var unmanaged []*rds.Parameter
+	err := conn.DescribeDBParametersPages(&rds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(name),
+		Source:               aws.String("user"),
+	}, func(page *rds.DescribeDBParametersOutput, lastPage bool) bool {
+		for _, param := range page.Parameters {
+			if managed[aws.StringValue(param.ParameterName)] {
+				continue
+			}
+
+			applyMethod := "pending-reboot"
+			if aws.StringValue(param.ApplyType) == "dynamic" {
+				applyMethod = "immediate"
+			}
+
+			unmanaged = append(unmanaged, &rds.Parameter{
+				ApplyMethod:   aws.String(applyMethod),
+				ParameterName: param.ParameterName,
+			})
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading DB Parameter Group (%s) parameters: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Unmanaged parameters to reset: %#v", unmanaged)
+
+	// We can only reset 20 parameters at a time, so walk them until
+	// we've got them all.
+	maxParams := 20
+	for len(unmanaged) > 0 {
+		var paramsToReset []*rds.Parameter
+		if len(unmanaged) <= maxParams {
+			paramsToReset, unmanaged = unmanaged[:], nil
+		} else {
+			paramsToReset, unmanaged = unmanaged[:maxParams], unmanaged[maxParams:]
+		}
+		resetOpts := rds.ResetDBParameterGroupInput{
+			DBParameterGroupName: aws.String(name),
+			Parameters:           paramsToReset,
+		}
+
+		log.Printf("[DEBUG] Reset DB Parameter Group: %s", resetOpts)
+		if _, err := conn.ResetDBParameterGroup(&resetOpts); err != nil {
+			return fmt.Errorf("Error resetting DB Parameter Group: %s", err)
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsDbParameterHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -392,6 +392,40 @@ func TestAccAWSDBParameterGroup_withApplyMethod(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBParameterGroup_ResetUnmanagedParameters(t *testing.T) {
+	var v rds.DBParameterGroup
+
+	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBParameterGroupConfigResetUnmanagedParameters(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBParameterGroupExists("aws_db_parameter_group.bar", &v),
+					testAccCheckAWSDbParameterGroupModifyParameter(&v, "character_set_client", "utf8"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSDBParameterGroupConfigResetUnmanagedParameters(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBParameterGroupExists("aws_db_parameter_group.bar", &v),
+					resource.TestCheckResourceAttr(
+						"aws_db_parameter_group.bar", "reset_unmanaged_parameters", "true"),
+					resource.TestCheckResourceAttr(
+						"aws_db_parameter_group.bar", "parameter.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_db_parameter_group.bar", "parameter.2421266705.name", "character_set_server"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBParameterGroup_Only(t *testing.T) {
 	var v rds.DBParameterGroup
 
@@ -456,6 +490,24 @@ func testAccCheckAWSDbParamaterGroupDisappears(v *rds.DBParameterGroup) resource
 	}
 }
 
+func testAccCheckAWSDbParameterGroupModifyParameter(v *rds.DBParameterGroup, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+		_, err := conn.ModifyDBParameterGroup(&rds.ModifyDBParameterGroupInput{
+			DBParameterGroupName: v.DBParameterGroupName,
+			Parameters: []*rds.Parameter{
+				{
+					ApplyMethod:    aws.String("immediate"),
+					ParameterName:  aws.String(name),
+					ParameterValue: aws.String(value),
+				},
+			},
+		})
+		return err
+	}
+}
+
 func testAccCheckAWSDBParameterGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).rdsconn
 
@@ -572,6 +624,20 @@ resource "aws_db_parameter_group" "bar" {
 }`, n)
 }
 
+func testAccAWSDBParameterGroupConfigResetUnmanagedParameters(n string) string {
+	return fmt.Sprintf(`
+resource "aws_db_parameter_group" "bar" {
+  name                       = "%s"
+  family                     = "mysql5.6"
+  reset_unmanaged_parameters = true
+
+  parameter {
+    name  = "character_set_server"
+    value = "utf8"
+  }
+}`, n)
+}
+
 func testAccAWSDBParameterGroupConfigWithApplyMethod(n string) string {
 	return fmt.Sprintf(`
 resource "aws_db_parameter_group" "bar" {
@@ -16,6 +16,7 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEbsSnapshotCreate,
 		Read:   resourceAwsEbsSnapshotRead,
+		Update: resourceAwsEbsSnapshotUpdate,
 		Delete: resourceAwsEbsSnapshotDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -57,7 +58,6 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 			"tags": {
 				Type:     schema.TypeMap,
 				Optional: true,
-				ForceNew: true,
 			},
 		},
 	}
@@ -73,6 +73,12 @@ func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 		request.Description = aws.String(v.(string))
 	}
 
+	// Tagging on creation is not supported in the China regions.
+	restricted := meta.(*AWSClient).IsChinaCloud()
+	if v, ok := d.GetOk("tags_all"); ok && !restricted {
+		request.TagSpecifications = ec2TagSpecificationsFromMap(v.(map[string]interface{}), ec2.ResourceTypeSnapshot)
+	}
+
 	res, err := conn.CreateSnapshot(request)
 	if err != nil {
 		return err
@@ -85,8 +91,10 @@ func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := setTags(conn, d); err != nil {
-		log.Printf("[WARN] error setting tags: %s", err)
+	if restricted {
+		if err := setTags(conn, d); err != nil {
+			log.Printf("[WARN] error setting tags: %s", err)
+		}
 	}
 
 	return resourceAwsEbsSnapshotRead(d, meta)
@@ -131,6 +139,16 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceAwsEbsSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EBS Snapshot (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEbsSnapshotRead(d, meta)
+}
+
 func resourceAwsEbsSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -93,6 +93,13 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 		request.SnapshotId = aws.String(value.(string))
 	}
 
+	// Tag the volume on creation, so it is never untagged for tag based IAM
+	// conditions. Tagging on creation is not supported in the China regions.
+	restricted := meta.(*AWSClient).IsChinaCloud()
+	if value, ok := d.GetOk("tags_all"); ok && !restricted {
+		request.TagSpecifications = ec2TagSpecificationsFromMap(value.(map[string]interface{}), ec2.ResourceTypeVolume)
+	}
+
 	// IOPs are only valid, and required for, storage type io1. The current minimu
 	// is 100. Instead of a hard validation we we only apply the IOPs to the
 	// request if the type is io1, and log a warning otherwise. This allows users
@@ -139,7 +146,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*result.VolumeId)
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok && restricted {
 		if err := setTags(conn, d); err != nil {
 			return errwrap.Wrapf("Error setting tags for EBS Volume: {{err}}", err)
 		}
@@ -150,7 +157,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return errwrap.Wrapf("Error updating tags for EBS Volume: {{err}}", err)
 		}
@@ -67,9 +67,10 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 			},
 
 			"execution_role_arn": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIamRoleArn,
 			},
 
 			"memory": {
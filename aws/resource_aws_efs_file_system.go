@@ -204,7 +204,7 @@ func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsEFS(conn, d)
 		if err != nil {
 			return fmt.Errorf("Error setting EC2 tags for EFS file system (%q): %s",
@@ -359,7 +359,7 @@ func hasEmptyFileSystems(fs *efs.DescribeFileSystemsOutput) bool {
 }
 
 func resourceAwsEfsDnsName(fileSystemId, region string) string {
-	return fmt.Sprintf("%s.efs.%s.amazonaws.com", fileSystemId, region)
+	return fmt.Sprintf("%s.efs.%s.%s", fileSystemId, region, partitionDnsSuffix(region))
 }
 
 func resourceEfsFileSystemCreateUpdateRefreshFunc(id string, conn *efs.EFS) resource.StateRefreshFunc {
@@ -287,7 +287,7 @@ func resourceAwsEfsMountTargetDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsEfsMountTargetDnsName(fileSystemId, region string) string {
-	return fmt.Sprintf("%s.efs.%s.amazonaws.com", fileSystemId, region)
+	return fmt.Sprintf("%s.efs.%s.%s", fileSystemId, region, partitionDnsSuffix(region))
 }
 
 func hasEmptyMountTargets(mto *efs.DescribeMountTargetsOutput) bool {
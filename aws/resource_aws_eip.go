@@ -120,7 +120,7 @@ func resourceAwsEipCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] EIP ID: %s (domain: %v)", d.Id(), *allocResp.Domain)
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(ec2conn, d); err != nil {
 			return fmt.Errorf("Error creating EIP tags: %s", err)
 		}
@@ -307,7 +307,7 @@ func resourceAwsEipUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(ec2conn, d); err != nil {
 			return fmt.Errorf("Error updating EIP tags: %s", err)
 		}
@@ -157,7 +157,7 @@ func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error
 		Timeout: d.Timeout(schema.TimeoutCreate),
 		Refresh: refreshEksClusterStatus(conn, name),
 	}
-	_, err = stateConf.WaitForState()
+	_, err = waitForStateWithProgress(&stateConf, fmt.Sprintf("EKS Cluster (%s) creation", d.Id()))
 	if err != nil {
 		return err
 	}
@@ -313,7 +313,7 @@ func waitForDeleteEksCluster(conn *eks.EKS, clusterName string, timeout time.Dur
 		Timeout: timeout,
 		Refresh: refreshEksClusterStatus(conn, clusterName),
 	}
-	cluster, err := stateConf.WaitForState()
+	cluster, err := waitForStateWithProgress(&stateConf, fmt.Sprintf("EKS Cluster (%s) deletion", clusterName))
 	if err != nil {
 		if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
 			return nil
@@ -141,7 +141,7 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
-	tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapEC(d.Get("tags_all").(map[string]interface{}))
 	params := &elasticache.CreateReplicationGroupInput{
 		ReplicationGroupId:          aws.String(d.Get("replication_group_id").(string)),
 		ReplicationGroupDescription: aws.String(d.Get("replication_group_description").(string)),
@@ -666,6 +666,15 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return fmt.Errorf("error waiting for Elasticache Replication Group (%s) to be updated: %s", d.Id(), err)
 		}
+
+		// Automatic failover changes are applied immediately, but the status
+		// can remain enabling or disabling after the group is available.
+		if d.HasChange("automatic_failover_enabled") {
+			err = waitForElasticacheReplicationGroupAutomaticFailover(conn, d.Id(), d.Get("automatic_failover_enabled").(bool), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return fmt.Errorf("error waiting for Elasticache Replication Group (%s) automatic failover to be updated: %s", d.Id(), err)
+			}
+		}
 	}
 	return resourceAwsElasticacheReplicationGroupRead(d, meta)
 }
@@ -802,6 +811,43 @@ func waitForModifyElasticacheReplicationGroup(conn *elasticache.ElastiCache, rep
 	return err
 }
 
+func waitForElasticacheReplicationGroupAutomaticFailover(conn *elasticache.ElastiCache, replicationGroupID string, enabled bool, timeout time.Duration) error {
+	// The status may still be the previous one right after the modification.
+	pending := []string{elasticache.AutomaticFailoverStatusDisabling, elasticache.AutomaticFailoverStatusEnabled}
+	target := elasticache.AutomaticFailoverStatusDisabled
+	if enabled {
+		pending = []string{elasticache.AutomaticFailoverStatusEnabling, elasticache.AutomaticFailoverStatusDisabled}
+		target = elasticache.AutomaticFailoverStatusEnabled
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{
+				ReplicationGroupId: aws.String(replicationGroupID),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			if len(resp.ReplicationGroups) == 0 {
+				return nil, "", fmt.Errorf("Elasticache Replication Group (%s) not found", replicationGroupID)
+			}
+
+			rg := resp.ReplicationGroups[0]
+
+			return rg, aws.StringValue(rg.AutomaticFailover), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Elasticache Replication Group (%s) automatic failover to be %s", replicationGroupID, target)
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func validateAwsElastiCacheReplicationGroupEngine(v interface{}, k string) (ws []string, errors []error) {
 	if strings.ToLower(v.(string)) != "redis" {
 		errors = append(errors, fmt.Errorf("The only acceptable Engine type when using Replication Groups is Redis"))
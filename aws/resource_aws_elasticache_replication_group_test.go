@@ -517,6 +517,54 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters(t *testing.T) {
 	})
 }
 
+func TestAccAWSElasticacheReplicationGroup_AutomaticFailover(t *testing.T) {
+	var replicationGroup elasticache.ReplicationGroup
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(4))
+	resourceName := "aws_elasticache_replication_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheReplicationGroupConfig_NumberCacheClusters(rName, 2, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheReplicationGroupExists(resourceName, &replicationGroup),
+					testAccCheckAWSElasticacheReplicationGroupAutomaticFailover(&replicationGroup, elasticache.AutomaticFailoverStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "automatic_failover_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheReplicationGroupConfig_NumberCacheClusters(rName, 2, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheReplicationGroupExists(resourceName, &replicationGroup),
+					testAccCheckAWSElasticacheReplicationGroupAutomaticFailover(&replicationGroup, elasticache.AutomaticFailoverStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "automatic_failover_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheReplicationGroupConfig_NumberCacheClusters(rName, 2, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheReplicationGroupExists(resourceName, &replicationGroup),
+					testAccCheckAWSElasticacheReplicationGroupAutomaticFailover(&replicationGroup, elasticache.AutomaticFailoverStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "automatic_failover_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSElasticacheReplicationGroupAutomaticFailover(rg *elasticache.ReplicationGroup, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if actual := aws.StringValue(rg.AutomaticFailover); actual != expected {
+			return fmt.Errorf("expected Elasticache Replication Group (%s) automatic failover %q, got: %q", aws.StringValue(rg.ReplicationGroupId), expected, actual)
+		}
+
+		return nil
+	}
+}
+
 func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFailoverDisabled(t *testing.T) {
 	var replicationGroup elasticache.ReplicationGroup
 	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(4))
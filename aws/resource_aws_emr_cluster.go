@@ -26,6 +26,13 @@ func resourceAwsEMRCluster() *schema.Resource {
 		Read:   resourceAwsEMRClusterRead,
 		Update: resourceAwsEMRClusterUpdate,
 		Delete: resourceAwsEMRClusterDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -265,6 +272,8 @@ func resourceAwsEMRCluster() *schema.Resource {
 					},
 				},
 			},
+			"master_instance_fleet": emrInstanceFleetSchema(),
+			"core_instance_fleet":   emrInstanceFleetSchema(),
 			"bootstrap_action": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -490,6 +499,12 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		instanceGroupConfigs := v.(*schema.Set).List()
 		instanceConfig.InstanceGroups = expandInstanceGroupConfigs(instanceGroupConfigs)
 	}
+	if v, ok := d.GetOk("master_instance_fleet"); ok {
+		instanceConfig.InstanceFleets = append(instanceConfig.InstanceFleets, expandEmrInstanceFleetConfig(v.([]interface{})[0].(map[string]interface{}), emr.InstanceFleetTypeMaster))
+	}
+	if v, ok := d.GetOk("core_instance_fleet"); ok {
+		instanceConfig.InstanceFleets = append(instanceConfig.InstanceFleets, expandEmrInstanceFleetConfig(v.([]interface{})[0].(map[string]interface{}), emr.InstanceFleetTypeCore))
+	}
 
 	emrApps := expandApplications(applications)
 
@@ -547,7 +562,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		steps := v.([]interface{})
 		params.Steps = expandEmrStepConfigs(steps)
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tagsIn := v.(map[string]interface{})
 		params.Tags = expandTags(tagsIn)
 	}
@@ -608,7 +623,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 			emr.ClusterStateWaiting,
 		},
 		Refresh:    resourceAwsEMRClusterStateRefreshFunc(d, meta),
-		Timeout:    75 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
@@ -664,6 +679,21 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if aws.StringValue(cluster.InstanceCollectionType) == emr.InstanceCollectionTypeInstanceFleet {
+		instanceFleets, err := fetchAllEMRInstanceFleets(emrconn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error listing EMR Cluster (%s) instance fleets: %s", d.Id(), err)
+		}
+
+		if err := d.Set("master_instance_fleet", flattenEmrInstanceFleet(findEMRInstanceFleet(instanceFleets, emr.InstanceFleetTypeMaster))); err != nil {
+			return fmt.Errorf("error setting master_instance_fleet: %s", err)
+		}
+
+		if err := d.Set("core_instance_fleet", flattenEmrInstanceFleet(findEMRInstanceFleet(instanceFleets, emr.InstanceFleetTypeCore))); err != nil {
+			return fmt.Errorf("error setting core_instance_fleet: %s", err)
+		}
+	}
+
 	d.Set("name", cluster.Name)
 
 	d.Set("service_role", cluster.ServiceRole)
@@ -798,7 +828,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 				emr.ClusterStateWaiting,
 			},
 			Refresh:    resourceAwsEMRClusterStateRefreshFunc(d, meta),
-			Timeout:    40 * time.Minute,
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 			Delay:      5 * time.Second,
 		}
@@ -809,6 +839,19 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	for _, k := range []string{"master_instance_fleet", "core_instance_fleet"} {
+		if !d.HasChange(k+".0.target_on_demand_capacity") && !d.HasChange(k+".0.target_spot_capacity") {
+			continue
+		}
+
+		d.SetPartial(k)
+
+		err := modifyEMRInstanceFleetCapacity(conn, d.Id(), d.Get(k+".0.id").(string), d.Get(k+".0.target_on_demand_capacity").(int), d.Get(k+".0.target_spot_capacity").(int), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("visible_to_all_users") {
 		d.SetPartial("visible_to_all_users")
 		_, errModify := conn.SetVisibleToAllUsers(&emr.SetVisibleToAllUsersInput{
@@ -859,7 +902,7 @@ func resourceAwsEMRClusterDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		resp, err := conn.ListInstances(&emr.ListInstancesInput{
 			ClusterId: aws.String(d.Id()),
 		})
@@ -1159,8 +1202,8 @@ func diffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
 }
 
 func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsEMR(expandTags(o), expandTags(n))
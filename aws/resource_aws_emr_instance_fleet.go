@@ -0,0 +1,603 @@
+package aws
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+var emrInstanceFleetNotFound = errors.New("No matching EMR Instance Fleet")
+
+func resourceAwsEMRInstanceFleet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEMRInstanceFleetCreate,
+		Read:   resourceAwsEMRInstanceFleetRead,
+		Update: resourceAwsEMRInstanceFleetUpdate,
+		Delete: resourceAwsEMRInstanceFleetDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_type_configs": emrInstanceTypeConfigsSchema(),
+			"launch_specifications": emrInstanceFleetLaunchSpecificationsSchema(),
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_on_demand_capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"target_spot_capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"provisioned_on_demand_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"provisioned_spot_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// emrInstanceFleetSchema returns the schema of the master_instance_fleet and
+// core_instance_fleet blocks of aws_emr_cluster. Only the target capacities can
+// be changed without recreating the cluster.
+func emrInstanceFleetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		ConflictsWith: []string{
+			"core_instance_count",
+			"core_instance_type",
+			"instance_group",
+			"master_instance_type",
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"instance_type_configs": emrInstanceTypeConfigsSchema(),
+				"launch_specifications": emrInstanceFleetLaunchSpecificationsSchema(),
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"target_on_demand_capacity": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  0,
+				},
+				"target_spot_capacity": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  0,
+				},
+				"provisioned_on_demand_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"provisioned_spot_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func emrInstanceTypeConfigsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bid_price": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"bid_price_as_percentage_of_on_demand_price": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Default:  100,
+				},
+				"ebs_config": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"iops": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							"size": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateAwsEmrEbsVolumeType(),
+							},
+							"volumes_per_instance": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Default:  1,
+							},
+						},
+					},
+					Set: resourceAwsEMRInstanceTypeConfigEbsConfigHash,
+				},
+				"instance_type": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"weighted_capacity": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  1,
+				},
+			},
+		},
+	}
+}
+
+func emrInstanceFleetLaunchSpecificationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"spot_specification": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"block_duration_minutes": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Default:  0,
+							},
+							"timeout_action": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									emr.SpotProvisioningTimeoutActionSwitchToOnDemand,
+									emr.SpotProvisioningTimeoutActionTerminateCluster,
+								}, false),
+							},
+							"timeout_duration_minutes": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(5, 1440),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsEMRInstanceTypeConfigEbsConfigHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%d-", m["iops"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["size"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["type"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["volumes_per_instance"].(int)))
+	return hashcode.String(buf.String())
+}
+
+func resourceAwsEMRInstanceFleetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	clusterId := d.Get("cluster_id").(string)
+
+	params := &emr.AddInstanceFleetInput{
+		ClusterId: aws.String(clusterId),
+		InstanceFleet: expandEmrInstanceFleetConfig(map[string]interface{}{
+			"instance_type_configs":     d.Get("instance_type_configs"),
+			"launch_specifications":     d.Get("launch_specifications"),
+			"name":                      d.Get("name"),
+			"target_on_demand_capacity": d.Get("target_on_demand_capacity"),
+			"target_spot_capacity":      d.Get("target_spot_capacity"),
+		}, emr.InstanceFleetTypeTask),
+	}
+
+	log.Printf("[DEBUG] Creating EMR task fleet: %s", params)
+	resp, err := conn.AddInstanceFleet(params)
+	if err != nil {
+		return fmt.Errorf("error creating EMR task fleet for cluster (%s): %s", clusterId, err)
+	}
+
+	if resp == nil || resp.InstanceFleetId == nil {
+		return fmt.Errorf("error creating EMR task fleet for cluster (%s): no instance fleet returned", clusterId)
+	}
+
+	d.SetId(aws.StringValue(resp.InstanceFleetId))
+
+	if err := waitForEMRInstanceFleetRunning(conn, clusterId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EMR Instance Fleet (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsEMRInstanceFleetRead(d, meta)
+}
+
+func resourceAwsEMRInstanceFleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	fleet, err := fetchEMRInstanceFleet(conn, d.Get("cluster_id").(string), d.Id())
+	if err == emrInstanceFleetNotFound {
+		log.Printf("[WARN] EMR Instance Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading EMR Instance Fleet (%s): %s", d.Id(), err)
+	}
+
+	if fleet.Status != nil && aws.StringValue(fleet.Status.State) == emr.InstanceFleetStateTerminated {
+		log.Printf("[WARN] EMR Instance Fleet (%s) terminated, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("instance_type_configs", flattenEmrInstanceTypeSpecifications(fleet.InstanceTypeSpecifications)); err != nil {
+		return fmt.Errorf("error setting instance_type_configs: %s", err)
+	}
+
+	if err := d.Set("launch_specifications", flattenEmrInstanceFleetProvisioningSpecifications(fleet.LaunchSpecifications)); err != nil {
+		return fmt.Errorf("error setting launch_specifications: %s", err)
+	}
+
+	d.Set("name", fleet.Name)
+	d.Set("provisioned_on_demand_capacity", fleet.ProvisionedOnDemandCapacity)
+	d.Set("provisioned_spot_capacity", fleet.ProvisionedSpotCapacity)
+	d.Set("target_on_demand_capacity", fleet.TargetOnDemandCapacity)
+	d.Set("target_spot_capacity", fleet.TargetSpotCapacity)
+
+	return nil
+}
+
+func resourceAwsEMRInstanceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	clusterId := d.Get("cluster_id").(string)
+
+	err := modifyEMRInstanceFleetCapacity(conn, clusterId, d.Id(), d.Get("target_on_demand_capacity").(int), d.Get("target_spot_capacity").(int), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsEMRInstanceFleetRead(d, meta)
+}
+
+func resourceAwsEMRInstanceFleetDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] AWS EMR Instance Fleet does not support DELETE; resizing fleet to zero before removing from state")
+	conn := meta.(*AWSClient).emrconn
+
+	params := &emr.ModifyInstanceFleetInput{
+		ClusterId: aws.String(d.Get("cluster_id").(string)),
+		InstanceFleet: &emr.InstanceFleetModifyConfig{
+			InstanceFleetId:        aws.String(d.Id()),
+			TargetOnDemandCapacity: aws.Int64(0),
+			TargetSpotCapacity:     aws.Int64(0),
+		},
+	}
+
+	log.Printf("[DEBUG] Modifying EMR Instance Fleet: %s", params)
+	_, err := conn.ModifyInstanceFleet(params)
+	if err != nil {
+		return fmt.Errorf("error resizing EMR Instance Fleet (%s) to zero: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// modifyEMRInstanceFleetCapacity changes the target capacities of an instance
+// fleet and waits for the resize to complete.
+func modifyEMRInstanceFleetCapacity(conn *emr.EMR, clusterId, fleetId string, onDemandCapacity, spotCapacity int, timeout time.Duration) error {
+	params := &emr.ModifyInstanceFleetInput{
+		ClusterId: aws.String(clusterId),
+		InstanceFleet: &emr.InstanceFleetModifyConfig{
+			InstanceFleetId:        aws.String(fleetId),
+			TargetOnDemandCapacity: aws.Int64(int64(onDemandCapacity)),
+			TargetSpotCapacity:     aws.Int64(int64(spotCapacity)),
+		},
+	}
+
+	log.Printf("[DEBUG] Modifying EMR Instance Fleet: %s", params)
+	if _, err := conn.ModifyInstanceFleet(params); err != nil {
+		return fmt.Errorf("error modifying EMR Instance Fleet (%s): %s", fleetId, err)
+	}
+
+	if err := waitForEMRInstanceFleetRunning(conn, clusterId, fleetId, timeout); err != nil {
+		return fmt.Errorf("error waiting for EMR Instance Fleet (%s) modification: %s", fleetId, err)
+	}
+
+	return nil
+}
+
+func waitForEMRInstanceFleetRunning(conn *emr.EMR, clusterId, fleetId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			emr.InstanceFleetStateBootstrapping,
+			emr.InstanceFleetStateProvisioning,
+			emr.InstanceFleetStateResizing,
+		},
+		Target:     []string{emr.InstanceFleetStateRunning},
+		Refresh:    instanceFleetStateRefresh(conn, clusterId, fleetId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func instanceFleetStateRefresh(conn *emr.EMR, clusterId, fleetId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fleet, err := fetchEMRInstanceFleet(conn, clusterId, fleetId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if fleet.Status == nil || fleet.Status.State == nil {
+			return nil, "", fmt.Errorf("Undefined EMR Cluster Instance Fleet state")
+		}
+
+		return fleet, aws.StringValue(fleet.Status.State), nil
+	}
+}
+
+func fetchAllEMRInstanceFleets(conn *emr.EMR, clusterId string) ([]*emr.InstanceFleet, error) {
+	req := &emr.ListInstanceFleetsInput{
+		ClusterId: aws.String(clusterId),
+	}
+
+	var fleets []*emr.InstanceFleet
+	err := conn.ListInstanceFleetsPages(req, func(page *emr.ListInstanceFleetsOutput, lastPage bool) bool {
+		fleets = append(fleets, page.InstanceFleets...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fleets, nil
+}
+
+func fetchEMRInstanceFleet(conn *emr.EMR, clusterId, fleetId string) (*emr.InstanceFleet, error) {
+	fleets, err := fetchAllEMRInstanceFleets(conn, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fleet := range fleets {
+		if aws.StringValue(fleet.Id) == fleetId {
+			return fleet, nil
+		}
+	}
+
+	return nil, emrInstanceFleetNotFound
+}
+
+func findEMRInstanceFleet(fleets []*emr.InstanceFleet, typ string) *emr.InstanceFleet {
+	for _, fleet := range fleets {
+		if aws.StringValue(fleet.InstanceFleetType) == typ {
+			return fleet
+		}
+	}
+	return nil
+}
+
+func expandEmrInstanceFleetConfig(m map[string]interface{}, fleetType string) *emr.InstanceFleetConfig {
+	config := &emr.InstanceFleetConfig{
+		InstanceFleetType:      aws.String(fleetType),
+		InstanceTypeConfigs:    expandEmrInstanceTypeConfigs(m["instance_type_configs"].(*schema.Set).List()),
+		TargetOnDemandCapacity: aws.Int64(int64(m["target_on_demand_capacity"].(int))),
+		TargetSpotCapacity:     aws.Int64(int64(m["target_spot_capacity"].(int))),
+	}
+
+	if v, ok := m["name"].(string); ok && v != "" {
+		config.Name = aws.String(v)
+	}
+
+	if v, ok := m["launch_specifications"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.LaunchSpecifications = expandEmrInstanceFleetProvisioningSpecifications(v[0].(map[string]interface{}))
+	}
+
+	return config
+}
+
+func expandEmrInstanceTypeConfigs(l []interface{}) []*emr.InstanceTypeConfig {
+	configs := make([]*emr.InstanceTypeConfig, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		config := &emr.InstanceTypeConfig{
+			InstanceType:     aws.String(m["instance_type"].(string)),
+			WeightedCapacity: aws.Int64(int64(m["weighted_capacity"].(int))),
+		}
+
+		if v, ok := m["bid_price"].(string); ok && v != "" {
+			config.BidPrice = aws.String(v)
+		} else if v, ok := m["bid_price_as_percentage_of_on_demand_price"].(float64); ok && v != 0 {
+			config.BidPriceAsPercentageOfOnDemandPrice = aws.Float64(v)
+		}
+
+		if v, ok := m["ebs_config"].(*schema.Set); ok && v.Len() > 0 {
+			ebsBlockDeviceConfigs := make([]*emr.EbsBlockDeviceConfig, 0)
+			for _, rawEbsConfig := range v.List() {
+				rawEbsConfig := rawEbsConfig.(map[string]interface{})
+				ebsBlockDeviceConfig := &emr.EbsBlockDeviceConfig{
+					VolumesPerInstance: aws.Int64(int64(rawEbsConfig["volumes_per_instance"].(int))),
+					VolumeSpecification: &emr.VolumeSpecification{
+						SizeInGB:   aws.Int64(int64(rawEbsConfig["size"].(int))),
+						VolumeType: aws.String(rawEbsConfig["type"].(string)),
+					},
+				}
+				if v, ok := rawEbsConfig["iops"].(int); ok && v != 0 {
+					ebsBlockDeviceConfig.VolumeSpecification.Iops = aws.Int64(int64(v))
+				}
+				ebsBlockDeviceConfigs = append(ebsBlockDeviceConfigs, ebsBlockDeviceConfig)
+			}
+
+			config.EbsConfiguration = &emr.EbsConfiguration{
+				EbsBlockDeviceConfigs: ebsBlockDeviceConfigs,
+			}
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs
+}
+
+func expandEmrInstanceFleetProvisioningSpecifications(m map[string]interface{}) *emr.InstanceFleetProvisioningSpecifications {
+	l := m["spot_specification"].([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	spot := l[0].(map[string]interface{})
+
+	spec := &emr.SpotProvisioningSpecification{
+		TimeoutAction:          aws.String(spot["timeout_action"].(string)),
+		TimeoutDurationMinutes: aws.Int64(int64(spot["timeout_duration_minutes"].(int))),
+	}
+
+	if v, ok := spot["block_duration_minutes"].(int); ok && v != 0 {
+		spec.BlockDurationMinutes = aws.Int64(int64(v))
+	}
+
+	return &emr.InstanceFleetProvisioningSpecifications{
+		SpotSpecification: spec,
+	}
+}
+
+func flattenEmrInstanceFleet(fleet *emr.InstanceFleet) []interface{} {
+	if fleet == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"id":                             aws.StringValue(fleet.Id),
+		"instance_type_configs":          flattenEmrInstanceTypeSpecifications(fleet.InstanceTypeSpecifications),
+		"launch_specifications":          flattenEmrInstanceFleetProvisioningSpecifications(fleet.LaunchSpecifications),
+		"name":                           aws.StringValue(fleet.Name),
+		"provisioned_on_demand_capacity": int(aws.Int64Value(fleet.ProvisionedOnDemandCapacity)),
+		"provisioned_spot_capacity":      int(aws.Int64Value(fleet.ProvisionedSpotCapacity)),
+		"target_on_demand_capacity":      int(aws.Int64Value(fleet.TargetOnDemandCapacity)),
+		"target_spot_capacity":           int(aws.Int64Value(fleet.TargetSpotCapacity)),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenEmrInstanceTypeSpecifications(specs []*emr.InstanceTypeSpecification) []interface{} {
+	l := make([]interface{}, 0, len(specs))
+
+	for _, spec := range specs {
+		m := map[string]interface{}{
+			"bid_price":         aws.StringValue(spec.BidPrice),
+			"ebs_config":        flattenEmrEbsBlockDevices(spec.EbsBlockDevices),
+			"instance_type":     aws.StringValue(spec.InstanceType),
+			"weighted_capacity": int(aws.Int64Value(spec.WeightedCapacity)),
+		}
+
+		if spec.BidPriceAsPercentageOfOnDemandPrice != nil {
+			m["bid_price_as_percentage_of_on_demand_price"] = aws.Float64Value(spec.BidPriceAsPercentageOfOnDemandPrice)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+// flattenEmrEbsBlockDevices groups the EBS volumes attached to each instance,
+// which EMR returns one per device, by their specification.
+func flattenEmrEbsBlockDevices(devices []*emr.EbsBlockDevice) *schema.Set {
+	counts := make(map[string]int)
+	configs := make(map[string]map[string]interface{})
+
+	for _, device := range devices {
+		spec := device.VolumeSpecification
+		if spec == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"iops": int(aws.Int64Value(spec.Iops)),
+			"size": int(aws.Int64Value(spec.SizeInGB)),
+			"type": aws.StringValue(spec.VolumeType),
+		}
+
+		key := fmt.Sprintf("%d-%d-%s", m["iops"], m["size"], m["type"])
+		counts[key]++
+		configs[key] = m
+	}
+
+	s := schema.NewSet(resourceAwsEMRInstanceTypeConfigEbsConfigHash, nil)
+	for key, m := range configs {
+		m["volumes_per_instance"] = counts[key]
+		s.Add(m)
+	}
+
+	return s
+}
+
+func flattenEmrInstanceFleetProvisioningSpecifications(spec *emr.InstanceFleetProvisioningSpecifications) []interface{} {
+	if spec == nil || spec.SpotSpecification == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"spot_specification": []interface{}{
+			map[string]interface{}{
+				"block_duration_minutes":   int(aws.Int64Value(spec.SpotSpecification.BlockDurationMinutes)),
+				"timeout_action":           aws.StringValue(spec.SpotSpecification.TimeoutAction),
+				"timeout_duration_minutes": int(aws.Int64Value(spec.SpotSpecification.TimeoutDurationMinutes)),
+			},
+		},
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,321 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEMRInstanceFleet_basic(t *testing.T) {
+	var fleet emr.InstanceFleet
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEmrInstanceFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEmrInstanceFleetConfig(rInt, 1, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrInstanceFleetExists("aws_emr_instance_fleet.task", &fleet),
+					resource.TestCheckResourceAttr("aws_emr_cluster.tf-test-cluster", "master_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttr("aws_emr_cluster.tf-test-cluster", "master_instance_fleet.0.target_on_demand_capacity", "1"),
+					resource.TestCheckResourceAttr("aws_emr_cluster.tf-test-cluster", "core_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttr("aws_emr_cluster.tf-test-cluster", "core_instance_fleet.0.instance_type_configs.#", "2"),
+					resource.TestCheckResourceAttr("aws_emr_instance_fleet.task", "instance_type_configs.#", "2"),
+					resource.TestCheckResourceAttr("aws_emr_instance_fleet.task", "launch_specifications.#", "1"),
+					resource.TestCheckResourceAttr("aws_emr_instance_fleet.task", "target_on_demand_capacity", "1"),
+					resource.TestCheckResourceAttr("aws_emr_instance_fleet.task", "target_spot_capacity", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Confirm the target capacities of the core and task fleets change in place.
+func TestAccAWSEMRInstanceFleet_capacity(t *testing.T) {
+	var before, after emr.InstanceFleet
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEmrInstanceFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEmrInstanceFleetConfig(rInt, 1, 0),
+				Check:  testAccCheckAWSEmrInstanceFleetExists("aws_emr_instance_fleet.task", &before),
+			},
+			{
+				Config: testAccAWSEmrInstanceFleetConfig(rInt, 2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrInstanceFleetExists("aws_emr_instance_fleet.task", &after),
+					testAccCheckAWSEmrInstanceFleetNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr("aws_emr_cluster.tf-test-cluster", "core_instance_fleet.0.target_on_demand_capacity", "2"),
+					resource.TestCheckResourceAttr("aws_emr_instance_fleet.task", "target_on_demand_capacity", "2"),
+					resource.TestCheckResourceAttr("aws_emr_instance_fleet.task", "target_spot_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEmrInstanceFleetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).emrconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emr_instance_fleet" {
+			continue
+		}
+
+		fleet, err := fetchEMRInstanceFleet(conn, rs.Primary.Attributes["cluster_id"], rs.Primary.ID)
+		if err == emrInstanceFleetNotFound {
+			continue
+		}
+		// The cluster no longer exists
+		if isAWSErr(err, emr.ErrCodeInvalidRequestException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if fleet.Status != nil && aws.StringValue(fleet.Status.State) == emr.InstanceFleetStateTerminated {
+			continue
+		}
+
+		return fmt.Errorf("EMR Instance Fleet (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEmrInstanceFleetExists(n string, v *emr.InstanceFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No task fleet id set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).emrconn
+		fleet, err := fetchEMRInstanceFleet(conn, rs.Primary.Attributes["cluster_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("EMR error: %v", err)
+		}
+
+		*v = *fleet
+		return nil
+	}
+}
+
+func testAccCheckAWSEmrInstanceFleetNotRecreated(before, after *emr.InstanceFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := *before.Id, *after.Id; before != after {
+			return fmt.Errorf("EMR Instance Fleet recreated: %s, %s", before, after)
+		}
+		return nil
+	}
+}
+
+func testAccAWSEmrInstanceFleetConfig(r, onDemandCapacity, spotCapacity int) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  region = "us-west-2"
+}
+
+resource "aws_emr_cluster" "tf-test-cluster" {
+  name          = "tf-test-emr-%[1]d"
+  release_label = "emr-5.16.0"
+  applications  = ["Spark"]
+
+  ec2_attributes {
+    subnet_id                         = "${aws_subnet.main.id}"
+    emr_managed_master_security_group = "${aws_security_group.allow_all.id}"
+    emr_managed_slave_security_group  = "${aws_security_group.allow_all.id}"
+    instance_profile                  = "${aws_iam_instance_profile.emr_profile.arn}"
+  }
+
+  master_instance_fleet {
+    instance_type_configs {
+      instance_type = "m4.large"
+    }
+
+    target_on_demand_capacity = 1
+  }
+
+  core_instance_fleet {
+    instance_type_configs {
+      instance_type     = "m4.large"
+      weighted_capacity = 1
+    }
+
+    instance_type_configs {
+      instance_type     = "m4.xlarge"
+      weighted_capacity = 2
+
+      ebs_config {
+        size                 = 32
+        type                 = "gp2"
+        volumes_per_instance = 2
+      }
+    }
+
+    target_on_demand_capacity = %[2]d
+  }
+
+  service_role = "${aws_iam_role.iam_emr_default_role.arn}"
+
+  depends_on = ["aws_internet_gateway.gw", "aws_iam_role_policy_attachment.service-attach", "aws_iam_role_policy_attachment.profile-attach"]
+}
+
+resource "aws_emr_instance_fleet" "task" {
+  cluster_id = "${aws_emr_cluster.tf-test-cluster.id}"
+  name       = "task fleet"
+
+  instance_type_configs {
+    instance_type                              = "m4.large"
+    bid_price_as_percentage_of_on_demand_price = 100
+  }
+
+  instance_type_configs {
+    instance_type                              = "m4.xlarge"
+    bid_price_as_percentage_of_on_demand_price = 100
+    weighted_capacity                          = 2
+  }
+
+  launch_specifications {
+    spot_specification {
+      timeout_action           = "SWITCH_TO_ON_DEMAND"
+      timeout_duration_minutes = 10
+    }
+  }
+
+  target_on_demand_capacity = %[2]d
+  target_spot_capacity      = %[3]d
+}
+
+resource "aws_security_group" "allow_all" {
+  name        = "tf-acc-emr-instance-fleet-%[1]d"
+  description = "Allow all inbound traffic"
+  vpc_id      = "${aws_vpc.main.id}"
+
+  ingress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  depends_on = ["aws_subnet.main"]
+
+  lifecycle {
+    ignore_changes = ["ingress", "egress"]
+  }
+}
+
+resource "aws_vpc" "main" {
+  cidr_block           = "168.31.0.0/16"
+  enable_dns_hostnames = true
+
+  tags {
+    Name = "terraform-testacc-emr-instance-fleet"
+  }
+}
+
+resource "aws_subnet" "main" {
+  vpc_id     = "${aws_vpc.main.id}"
+  cidr_block = "168.31.0.0/20"
+
+  tags {
+    Name = "tf-acc-emr-instance-fleet"
+  }
+}
+
+resource "aws_internet_gateway" "gw" {
+  vpc_id = "${aws_vpc.main.id}"
+}
+
+resource "aws_route_table" "r" {
+  vpc_id = "${aws_vpc.main.id}"
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = "${aws_internet_gateway.gw.id}"
+  }
+}
+
+resource "aws_main_route_table_association" "a" {
+  vpc_id         = "${aws_vpc.main.id}"
+  route_table_id = "${aws_route_table.r.id}"
+}
+
+resource "aws_iam_role" "iam_emr_default_role" {
+  name = "iam_emr_default_role_%[1]d"
+
+  assume_role_policy = <<EOT
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "elasticmapreduce.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOT
+}
+
+resource "aws_iam_role_policy_attachment" "service-attach" {
+  role       = "${aws_iam_role.iam_emr_default_role.id}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AmazonElasticMapReduceRole"
+}
+
+resource "aws_iam_role" "iam_emr_profile_role" {
+  name = "iam_emr_profile_role_%[1]d"
+
+  assume_role_policy = <<EOT
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOT
+}
+
+resource "aws_iam_instance_profile" "emr_profile" {
+  name = "emr_profile_%[1]d"
+  role = "${aws_iam_role.iam_emr_profile_role.name}"
+}
+
+resource "aws_iam_role_policy_attachment" "profile-attach" {
+  role       = "${aws_iam_role.iam_emr_profile_role.id}"
+  policy_arn = "arn:aws:iam::aws:policy/service-role/AmazonElasticMapReduceforEC2Role"
+}
+`, r, onDemandCapacity, spotCapacity)
+}
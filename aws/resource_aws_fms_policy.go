@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resource_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+							ValidateFunc:     validateJsonString,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								fms.SecurityServiceTypeWaf,
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.PutPolicyInput{
+		Policy: expandFmsPolicy(d),
+	}
+
+	log.Printf("[DEBUG] Creating FMS Policy: %s", input)
+	output, err := conn.PutPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error creating FMS Policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.GetPolicyInput{
+		PolicyId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading FMS Policy: %s", input)
+	output, err := conn.GetPolicy(input)
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Policy == nil {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	policy := output.Policy
+
+	d.Set("arn", output.PolicyArn)
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+	d.Set("name", policy.PolicyName)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+	d.Set("resource_type", policy.ResourceType)
+
+	if err := d.Set("resource_tags", flattenFmsResourceTags(policy.ResourceTags)); err != nil {
+		return fmt.Errorf("error setting resource_tags: %s", err)
+	}
+
+	if err := d.Set("security_service_policy_data", flattenFmsSecurityServicePolicyData(policy.SecurityServicePolicyData)); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	policy := expandFmsPolicy(d)
+	policy.PolicyId = aws.String(d.Id())
+	policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+
+	input := &fms.PutPolicyInput{
+		Policy: policy,
+	}
+
+	log.Printf("[DEBUG] Updating FMS Policy: %s", input)
+	_, err := conn.PutPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error updating FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.DeletePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting FMS Policy: %s", input)
+	_, err := conn.DeletePolicy(input)
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFmsPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		ExcludeResourceTags:       aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		PolicyName:                aws.String(d.Get("name").(string)),
+		RemediationEnabled:        aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:              expandFmsResourceTags(d.Get("resource_tags").(map[string]interface{})),
+		ResourceType:              aws.String(d.Get("resource_type").(string)),
+		SecurityServicePolicyData: expandFmsSecurityServicePolicyData(d.Get("security_service_policy_data").([]interface{})),
+	}
+
+	return policy
+}
+
+func expandFmsResourceTags(m map[string]interface{}) []*fms.ResourceTag {
+	resourceTags := make([]*fms.ResourceTag, 0, len(m))
+	for k, v := range m {
+		resourceTags = append(resourceTags, &fms.ResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return resourceTags
+}
+
+func flattenFmsResourceTags(resourceTags []*fms.ResourceTag) map[string]interface{} {
+	m := make(map[string]interface{}, len(resourceTags))
+	for _, resourceTag := range resourceTags {
+		m[aws.StringValue(resourceTag.Key)] = aws.StringValue(resourceTag.Value)
+	}
+
+	return m
+}
+
+func expandFmsSecurityServicePolicyData(l []interface{}) *fms.SecurityServicePolicyData {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	securityServicePolicyData := &fms.SecurityServicePolicyData{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["managed_service_data"].(string); ok && v != "" {
+		securityServicePolicyData.ManagedServiceData = aws.String(v)
+	}
+
+	return securityServicePolicyData
+}
+
+func flattenFmsSecurityServicePolicyData(securityServicePolicyData *fms.SecurityServicePolicyData) []interface{} {
+	if securityServicePolicyData == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"managed_service_data": aws.StringValue(securityServicePolicyData.ManagedServiceData),
+		"type":                 aws.StringValue(securityServicePolicyData.Type),
+	}
+
+	return []interface{}{m}
+}
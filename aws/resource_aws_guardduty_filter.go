@@ -0,0 +1,349 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGuardDutyFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyFilterCreate,
+		Read:   resourceAwsGuardDutyFilterRead,
+		Update: resourceAwsGuardDutyFilterUpdate,
+		Delete: resourceAwsGuardDutyFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					guardduty.FilterActionArchive,
+					guardduty.FilterActionNoop,
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"finding_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criterion": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"equals": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"field": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"greater_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionInteger,
+									},
+									"greater_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionInteger,
+									},
+									"less_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionInteger,
+									},
+									"less_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionInteger,
+									},
+									"not_equals": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,64}$`),
+					"must be 3 to 64 characters long and contain only alphanumeric characters, periods, underscores and hyphens",
+				),
+			},
+			"rank": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID := d.Get("detector_id").(string)
+	name := d.Get("name").(string)
+
+	findingCriteria, err := expandGuardDutyFilterFindingCriteria(d.Get("finding_criteria").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.CreateFilterInput{
+		Action:          aws.String(d.Get("action").(string)),
+		DetectorId:      aws.String(detectorID),
+		FindingCriteria: findingCriteria,
+		Name:            aws.String(name),
+		Rank:            aws.Int64(int64(d.Get("rank").(int))),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty Filter: %s", input)
+	_, err = conn.CreateFilter(input)
+	if err != nil {
+		return fmt.Errorf("error creating GuardDuty Filter: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, name))
+
+	return resourceAwsGuardDutyFilterRead(d, meta)
+}
+
+func resourceAwsGuardDutyFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, name, err := decodeGuardDutyFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.GetFilterInput{
+		DetectorId: aws.String(detectorID),
+		FilterName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty Filter: %s", input)
+	resp, err := conn.GetFilter(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") ||
+			isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected since no such resource found.") {
+			log.Printf("[WARN] GuardDuty Filter %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading GuardDuty Filter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", resp.Action)
+	d.Set("description", resp.Description)
+	d.Set("detector_id", detectorID)
+	d.Set("name", resp.Name)
+	d.Set("rank", resp.Rank)
+
+	if err := d.Set("finding_criteria", flattenGuardDutyFilterFindingCriteria(resp.FindingCriteria)); err != nil {
+		return fmt.Errorf("error setting finding_criteria: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGuardDutyFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, name, err := decodeGuardDutyFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	findingCriteria, err := expandGuardDutyFilterFindingCriteria(d.Get("finding_criteria").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.UpdateFilterInput{
+		Action:          aws.String(d.Get("action").(string)),
+		Description:     aws.String(d.Get("description").(string)),
+		DetectorId:      aws.String(detectorID),
+		FilterName:      aws.String(name),
+		FindingCriteria: findingCriteria,
+		Rank:            aws.Int64(int64(d.Get("rank").(int))),
+	}
+
+	log.Printf("[DEBUG] Updating GuardDuty Filter: %s", input)
+	_, err = conn.UpdateFilter(input)
+	if err != nil {
+		return fmt.Errorf("error updating GuardDuty Filter (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGuardDutyFilterRead(d, meta)
+}
+
+func resourceAwsGuardDutyFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, name, err := decodeGuardDutyFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DeleteFilterInput{
+		DetectorId: aws.String(detectorID),
+		FilterName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Deleting GuardDuty Filter: %s", input)
+	_, err = conn.DeleteFilter(input)
+	if err != nil {
+		return fmt.Errorf("error deleting GuardDuty Filter (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeGuardDutyFilterID(id string) (detectorID, name string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = fmt.Errorf("GuardDuty Filter ID must be of the form <Detector ID>:<Filter Name>, was provided: %s", id)
+		return
+	}
+	detectorID = parts[0]
+	name = parts[1]
+	return
+}
+
+func validateGuardDutyFilterConditionInteger(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an integer, got: %s", k, value))
+	}
+	return
+}
+
+func expandGuardDutyFilterFindingCriteria(l []interface{}) (*guardduty.FindingCriteria, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+	criterion := make(map[string]*guardduty.Condition)
+
+	for _, raw := range m["criterion"].(*schema.Set).List() {
+		c := raw.(map[string]interface{})
+		field := c["field"].(string)
+		condition := &guardduty.Condition{}
+
+		if v, ok := c["equals"].([]interface{}); ok && len(v) > 0 {
+			condition.Eq = expandStringList(v)
+		}
+		if v, ok := c["not_equals"].([]interface{}); ok && len(v) > 0 {
+			condition.Neq = expandStringList(v)
+		}
+
+		for key, target := range map[string]**int64{
+			"greater_than":          &condition.Gt,
+			"greater_than_or_equal": &condition.Gte,
+			"less_than":             &condition.Lt,
+			"less_than_or_equal":    &condition.Lte,
+		} {
+			v, ok := c[key].(string)
+			if !ok || v == "" {
+				continue
+			}
+			i, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s for GuardDuty Filter criterion %q: %s", key, field, err)
+			}
+			*target = aws.Int64(i)
+		}
+
+		criterion[field] = condition
+	}
+
+	return &guardduty.FindingCriteria{
+		Criterion: criterion,
+	}, nil
+}
+
+func flattenGuardDutyFilterFindingCriteria(findingCriteria *guardduty.FindingCriteria) []interface{} {
+	if findingCriteria == nil {
+		return []interface{}{}
+	}
+
+	fields := make([]string, 0, len(findingCriteria.Criterion))
+	for field := range findingCriteria.Criterion {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	criteria := make([]interface{}, 0, len(fields))
+	for _, field := range fields {
+		condition := findingCriteria.Criterion[field]
+		if condition == nil {
+			continue
+		}
+
+		c := map[string]interface{}{
+			"equals":     flattenStringList(condition.Eq),
+			"field":      field,
+			"not_equals": flattenStringList(condition.Neq),
+		}
+
+		if condition.Gt != nil {
+			c["greater_than"] = strconv.FormatInt(aws.Int64Value(condition.Gt), 10)
+		}
+		if condition.Gte != nil {
+			c["greater_than_or_equal"] = strconv.FormatInt(aws.Int64Value(condition.Gte), 10)
+		}
+		if condition.Lt != nil {
+			c["less_than"] = strconv.FormatInt(aws.Int64Value(condition.Lt), 10)
+		}
+		if condition.Lte != nil {
+			c["less_than_or_equal"] = strconv.FormatInt(aws.Int64Value(condition.Lte), 10)
+		}
+
+		criteria = append(criteria, c)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"criterion": criteria,
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsGuardDutyFilter_basic(t *testing.T) {
+	resourceName := "aws_guardduty_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyFilterConfig_full("ARCHIVE", "4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test-filter"),
+					resource.TestCheckResourceAttr(resourceName, "action", "ARCHIVE"),
+					resource.TestCheckResourceAttr(resourceName, "rank", "1"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.0.criterion.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGuardDutyFilterConfig_full("NOOP", "7"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "NOOP"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.0.criterion.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyFilterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_filter" {
+			continue
+		}
+
+		detectorID, name, err := decodeGuardDutyFilterID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetFilter(&guardduty.GetFilterInput{
+			DetectorId: aws.String(detectorID),
+			FilterName: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") ||
+				isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected since no such resource found.") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Expected GuardDuty Filter to be destroyed, %s found", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsGuardDutyFilterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		detectorID, filterName, err := decodeGuardDutyFilterID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		_, err = conn.GetFilter(&guardduty.GetFilterInput{
+			DetectorId: aws.String(detectorID),
+			FilterName: aws.String(filterName),
+		})
+
+		return err
+	}
+}
+
+func testAccGuardDutyFilterConfig_full(action, severity string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_filter" "test" {
+  detector_id = "${aws_guardduty_detector.test.id}"
+  name        = "test-filter"
+  action      = %[1]q
+  rank        = 1
+
+  finding_criteria {
+    criterion {
+      field  = "region"
+      equals = ["${data.aws_region.current.name}"]
+    }
+
+    criterion {
+      field      = "service.additionalInfo.threatListName"
+      not_equals = ["some-threat", "another-threat"]
+    }
+
+    criterion {
+      field                 = "severity"
+      greater_than_or_equal = %[2]q
+    }
+  }
+}
+`, action, severity)
+}
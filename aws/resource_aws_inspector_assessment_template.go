@@ -1,18 +1,21 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAWSInspectorAssessmentTemplate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsInspectorAssessmentTemplateCreate,
 		Read:   resourceAwsInspectorAssessmentTemplateRead,
+		Update: resourceAwsInspectorAssessmentTemplateUpdate,
 		Delete: resourceAwsInspectorAssessmentTemplateDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -43,6 +46,30 @@ func resourceAWSInspectorAssessmentTemplate() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"event_subscription": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								inspector.EventAssessmentRunStarted,
+								inspector.EventAssessmentRunCompleted,
+								inspector.EventAssessmentRunStateChanged,
+								inspector.EventFindingReported,
+								inspector.EventOther,
+							}, false),
+						},
+						"topic_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -74,6 +101,12 @@ func resourceAwsInspectorAssessmentTemplateCreate(d *schema.ResourceData, meta i
 
 	d.SetId(*resp.AssessmentTemplateArn)
 
+	if v, ok := d.GetOk("event_subscription"); ok {
+		if err := inspectorAssessmentTemplateSubscribeToEvents(conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsInspectorAssessmentTemplateRead(d, meta)
 }
 
@@ -95,12 +128,65 @@ func resourceAwsInspectorAssessmentTemplateRead(d *schema.ResourceData, meta int
 		}
 	}
 
-	if resp.AssessmentTemplates != nil && len(resp.AssessmentTemplates) > 0 {
-		d.Set("name", resp.AssessmentTemplates[0].Name)
+	if resp.AssessmentTemplates == nil || len(resp.AssessmentTemplates) == 0 {
+		log.Printf("[WARN] Inspector Assessment Template %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	template := resp.AssessmentTemplates[0]
+
+	d.Set("arn", template.Arn)
+	d.Set("duration", template.DurationInSeconds)
+	d.Set("name", template.Name)
+	d.Set("target_arn", template.AssessmentTargetArn)
+
+	if err := d.Set("rules_package_arns", flattenStringList(template.RulesPackageArns)); err != nil {
+		return fmt.Errorf("error setting rules_package_arns: %s", err)
+	}
+
+	subscriptions, err := readInspectorAssessmentTemplateEventSubscriptions(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Inspector Assessment Template (%s) event subscriptions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("event_subscription", subscriptions); err != nil {
+		return fmt.Errorf("error setting event_subscription: %s", err)
 	}
+
 	return nil
 }
 
+func resourceAwsInspectorAssessmentTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspectorconn
+
+	if d.HasChange("event_subscription") {
+		o, n := d.GetChange("event_subscription")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, raw := range os.Difference(ns).List() {
+			m := raw.(map[string]interface{})
+			input := &inspector.UnsubscribeFromEventInput{
+				Event:       aws.String(m["event"].(string)),
+				ResourceArn: aws.String(d.Id()),
+				TopicArn:    aws.String(m["topic_arn"].(string)),
+			}
+
+			log.Printf("[DEBUG] Unsubscribing Inspector Assessment Template from event: %s", input)
+			if _, err := conn.UnsubscribeFromEvent(input); err != nil {
+				return fmt.Errorf("error unsubscribing Inspector Assessment Template (%s) from event: %s", d.Id(), err)
+			}
+		}
+
+		if err := inspectorAssessmentTemplateSubscribeToEvents(conn, d.Id(), ns.Difference(os).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsInspectorAssessmentTemplateRead(d, meta)
+}
+
 func resourceAwsInspectorAssessmentTemplateDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).inspectorconn
 
@@ -119,3 +205,43 @@ func resourceAwsInspectorAssessmentTemplateDelete(d *schema.ResourceData, meta i
 
 	return nil
 }
+
+func inspectorAssessmentTemplateSubscribeToEvents(conn *inspector.Inspector, arn string, subscriptions []interface{}) error {
+	for _, raw := range subscriptions {
+		m := raw.(map[string]interface{})
+		input := &inspector.SubscribeToEventInput{
+			Event:       aws.String(m["event"].(string)),
+			ResourceArn: aws.String(arn),
+			TopicArn:    aws.String(m["topic_arn"].(string)),
+		}
+
+		log.Printf("[DEBUG] Subscribing Inspector Assessment Template to event: %s", input)
+		if _, err := conn.SubscribeToEvent(input); err != nil {
+			return fmt.Errorf("error subscribing Inspector Assessment Template (%s) to event: %s", arn, err)
+		}
+	}
+
+	return nil
+}
+
+func readInspectorAssessmentTemplateEventSubscriptions(conn *inspector.Inspector, arn string) ([]interface{}, error) {
+	subscriptions := make([]interface{}, 0)
+
+	input := &inspector.ListEventSubscriptionsInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	err := conn.ListEventSubscriptionsPages(input, func(page *inspector.ListEventSubscriptionsOutput, lastPage bool) bool {
+		for _, subscription := range page.Subscriptions {
+			for _, eventSubscription := range subscription.EventSubscriptions {
+				subscriptions = append(subscriptions, map[string]interface{}{
+					"event":     aws.StringValue(eventSubscription.Event),
+					"topic_arn": aws.StringValue(subscription.TopicArn),
+				})
+			}
+		}
+		return !lastPage
+	})
+
+	return subscriptions, err
+}
@@ -36,6 +36,40 @@ func TestAccAWSInspectorTemplate_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSInspectorTemplate_eventSubscription(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_inspector_assessment_template.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSInspectorTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSInspectorTemplateAssessmentEventSubscription(rInt, "ASSESSMENT_RUN_COMPLETED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSInspectorTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_subscription.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSInspectorTemplateAssessmentEventSubscription(rInt, "FINDING_REPORTED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSInspectorTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_subscription.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSInspectorTemplateAssessment(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSInspectorTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_subscription.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSInspectorTemplateDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).inspectorconn
 
@@ -130,3 +164,39 @@ resource "aws_inspector_assessment_template" "foo" {
   ]
 }`, rInt, rInt, rInt)
 }
+
+func testAccAWSInspectorTemplateAssessmentEventSubscription(rInt int, event string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector_resource_group" "foo" {
+  tags {
+    Name = "tf-acc-test-%[1]d"
+  }
+}
+
+resource "aws_inspector_assessment_target" "foo" {
+  name               = "tf-acc-test-basic-%[1]d"
+  resource_group_arn = "${aws_inspector_resource_group.foo.arn}"
+}
+
+resource "aws_sns_topic" "foo" {
+  name = "tf-acc-test-inspector-%[1]d"
+}
+
+resource "aws_inspector_assessment_template" "foo" {
+  name       = "tf-acc-test-basic-tpl-%[1]d"
+  target_arn = "${aws_inspector_assessment_target.foo.arn}"
+  duration   = 3600
+
+  rules_package_arns = [
+    "arn:aws:inspector:us-west-2:758058086616:rulespackage/0-9hgA516p",
+    "arn:aws:inspector:us-west-2:758058086616:rulespackage/0-H5hpSawc",
+    "arn:aws:inspector:us-west-2:758058086616:rulespackage/0-JJOtZiqQ",
+    "arn:aws:inspector:us-west-2:758058086616:rulespackage/0-vg5GGHSD",
+  ]
+
+  event_subscription {
+    event     = %[2]q
+    topic_arn = "${aws_sns_topic.foo.arn}"
+  }
+}`, rInt, event)
+}
@@ -81,8 +81,9 @@ func s3ConfigurationSchema() *schema.Schema {
 				},
 
 				"role_arn": {
-					Type:     schema.TypeString,
-					Required: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateIamRoleArn,
 				},
 
 				"prefix": {
@@ -976,8 +977,9 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 													Computed: true,
 												},
 												"role_arn": {
-													Type:     schema.TypeString,
-													Required: true,
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateIamRoleArn,
 												},
 												"table_name": {
 													Type:     schema.TypeString,
@@ -1002,8 +1004,9 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						},
 
 						"role_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 
 						"prefix": {
@@ -1059,8 +1062,9 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						"processing_configuration": processingConfigurationSchema(),
 
 						"role_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 
 						"s3_backup_mode": {
@@ -1186,8 +1190,9 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 						},
 
 						"role_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 
 						"s3_backup_mode": {
@@ -0,0 +1,370 @@
+package aws
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKmsExternalKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsExternalKeyCreate,
+		Read:   resourceAwsKmsExternalKeyRead,
+		Update: resourceAwsKmsExternalKeyUpdate,
+		Delete: resourceAwsKmsExternalKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(7, 30),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 8192),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"expiration_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_material_base64": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_usage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"tags": tagsSchema(),
+			"valid_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339TimeString,
+			},
+		},
+	}
+}
+
+func resourceAwsKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.CreateKeyInput{
+		KeyUsage: aws.String(kms.KeyUsageTypeEncryptDecrypt),
+		Origin:   aws.String(kms.OriginTypeExternal),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy"); ok {
+		input.Policy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = tagsFromMapKMS(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating KMS External Key: %s", input)
+	var output *kms.CreateKeyOutput
+	// AWS requires any principal in the policy to exist before the key is created.
+	err := resource.Retry(30*time.Second, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateKey(input)
+		if isAWSErr(err, kms.ErrCodeMalformedPolicyDocumentException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating KMS External Key: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.KeyMetadata.KeyId))
+
+	if v, ok := d.GetOk("key_material_base64"); ok {
+		if err := importKmsExternalKeyMaterial(conn, d.Id(), v.(string), d.Get("valid_to").(string)); err != nil {
+			return fmt.Errorf("error importing KMS External Key (%s) material: %s", d.Id(), err)
+		}
+
+		// Keys are enabled by the import of their material
+		if v, ok := d.GetOkExists("enabled"); ok && !v.(bool) {
+			if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+				return fmt.Errorf("error disabling KMS External Key (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+func resourceAwsKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.DescribeKeyInput{
+		KeyId: aws.String(d.Id()),
+	}
+
+	var output *kms.DescribeKeyOutput
+	var err error
+	if d.IsNewResource() {
+		var out interface{}
+		out, err = retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
+			return conn.DescribeKey(input)
+		})
+		output, _ = out.(*kms.DescribeKeyOutput)
+	} else {
+		output, err = conn.DescribeKey(input)
+	}
+
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] KMS External Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing KMS External Key (%s): %s", d.Id(), err)
+	}
+
+	metadata := output.KeyMetadata
+
+	if aws.StringValue(metadata.KeyState) == kms.KeyStatePendingDeletion {
+		log.Printf("[WARN] KMS External Key (%s) is pending deletion, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", metadata.Arn)
+	d.Set("description", metadata.Description)
+	d.Set("enabled", metadata.Enabled)
+	d.Set("expiration_model", metadata.ExpirationModel)
+	d.Set("key_state", metadata.KeyState)
+	d.Set("key_usage", metadata.KeyUsage)
+
+	if metadata.ValidTo != nil {
+		d.Set("valid_to", aws.TimeValue(metadata.ValidTo).Format(time.RFC3339))
+	} else {
+		d.Set("valid_to", "")
+	}
+
+	policyOutput, err := conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
+		KeyId:      metadata.KeyId,
+		PolicyName: aws.String("default"),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting KMS External Key (%s) policy: %s", d.Id(), err)
+	}
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(policyOutput.Policy))
+	if err != nil {
+		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	}
+	d.Set("policy", policy)
+
+	tagsOutput, err := conn.ListResourceTags(&kms.ListResourceTagsInput{
+		KeyId: metadata.KeyId,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing KMS External Key (%s) tags: %s", d.Id(), err)
+	}
+	d.Set("tags", tagsToMapKMS(tagsOutput.Tags))
+
+	return nil
+}
+
+func resourceAwsKmsExternalKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	// Enable before any attributes will be modified
+	if d.HasChange("enabled") && d.Get("enabled").(bool) {
+		if err := updateKmsKeyStatus(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("description") {
+		input := &kms.UpdateKeyDescriptionInput{
+			Description: aws.String(d.Get("description").(string)),
+			KeyId:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating KMS External Key description: %s", input)
+		if _, err := conn.UpdateKeyDescription(input); err != nil {
+			return fmt.Errorf("error updating KMS External Key (%s) description: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy") {
+		policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+		if err != nil {
+			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+		}
+
+		input := &kms.PutKeyPolicyInput{
+			KeyId:      aws.String(d.Id()),
+			Policy:     aws.String(policy),
+			PolicyName: aws.String("default"),
+		}
+
+		log.Printf("[DEBUG] Updating KMS External Key policy: %s", input)
+		if _, err := conn.PutKeyPolicy(input); err != nil {
+			return fmt.Errorf("error updating KMS External Key (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	// The same key material can be imported again with a new expiration
+	if d.HasChange("valid_to") {
+		if v, ok := d.GetOk("key_material_base64"); ok {
+			if err := importKmsExternalKeyMaterial(conn, d.Id(), v.(string), d.Get("valid_to").(string)); err != nil {
+				return fmt.Errorf("error importing KMS External Key (%s) material: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("enabled") && !d.Get("enabled").(bool) {
+		// Only disable when all attributes are modified
+		// because we cannot modify disabled keys
+		if err := updateKmsKeyStatus(conn, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsKMS(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error updating KMS External Key (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsKmsExternalKeyRead(d, meta)
+}
+
+func resourceAwsKmsExternalKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	err := deleteKmsKey(conn, d.Id(), d.Get("deletion_window_in_days").(int))
+
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting KMS External Key (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// importKmsExternalKeyMaterial wraps the base64 encoded key material with the
+// public key of a new import token and imports it into the KMS key. The key
+// material never expires unless validTo, an RFC3339 timestamp, is set.
+func importKmsExternalKeyMaterial(conn *kms.KMS, keyId, keyMaterialBase64, validTo string) error {
+	keyMaterial, err := base64.StdEncoding.DecodeString(keyMaterialBase64)
+	if err != nil {
+		return fmt.Errorf("error decoding key material: %s", err)
+	}
+
+	parametersInput := &kms.GetParametersForImportInput{
+		KeyId:             aws.String(keyId),
+		WrappingAlgorithm: aws.String(kms.AlgorithmSpecRsaesOaepSha256),
+		WrappingKeySpec:   aws.String(kms.WrappingKeySpecRsa2048),
+	}
+
+	log.Printf("[DEBUG] Getting KMS External Key import parameters: %s", parametersInput)
+	var parametersOutput *kms.GetParametersForImportOutput
+	// The key may not be visible to GetParametersForImport right after creation
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		parametersOutput, err = conn.GetParametersForImport(parametersInput)
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error getting import parameters: %s", err)
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(parametersOutput.PublicKey)
+	if err != nil {
+		return fmt.Errorf("error parsing import public key: %s", err)
+	}
+
+	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("unexpected import public key type: %T", publicKey)
+	}
+
+	encryptedKeyMaterial, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPublicKey, keyMaterial, []byte{})
+	if err != nil {
+		return fmt.Errorf("error wrapping key material: %s", err)
+	}
+
+	input := &kms.ImportKeyMaterialInput{
+		EncryptedKeyMaterial: encryptedKeyMaterial,
+		ExpirationModel:      aws.String(kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+		ImportToken:          parametersOutput.ImportToken,
+		KeyId:                aws.String(keyId),
+	}
+
+	if validTo != "" {
+		t, err := time.Parse(time.RFC3339, validTo)
+		if err != nil {
+			return fmt.Errorf("error parsing valid_to: %s", err)
+		}
+
+		input.ExpirationModel = aws.String(kms.ExpirationModelTypeKeyMaterialExpires)
+		input.ValidTo = aws.Time(t)
+	}
+
+	log.Printf("[DEBUG] Importing KMS External Key material: %s", keyId)
+	if _, err := conn.ImportKeyMaterial(input); err != nil {
+		return err
+	}
+
+	return nil
+}
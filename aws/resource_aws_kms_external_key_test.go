@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKmsExternalKey_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := fmt.Sprintf("tf-acc-test-kms-key-%s", acctest.RandString(5))
+	resourceName := "aws_kms_external_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", ""),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStatePendingImport),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", kms.KeyUsageTypeEncryptDecrypt),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsExternalKey_keyMaterial(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := fmt.Sprintf("tf-acc-test-kms-key-%s", acctest.RandString(5))
+	resourceName := "aws_kms_external_key.test"
+	validTo := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccCheckAWSKmsKeyIsEnabled(&key, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", ""),
+				),
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigKeyMaterial(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccCheckAWSKmsKeyIsEnabled(&key, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.KeyStateDisabled),
+				),
+			},
+			{
+				Config: testAccAWSKmsExternalKeyConfigValidTo(rName, validTo),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", kms.ExpirationModelTypeKeyMaterialExpires),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", validTo),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKmsExternalKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_external_key" {
+			continue
+		}
+
+		out, err := conn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(out.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion {
+			continue
+		}
+
+		return fmt.Errorf("KMS External Key still exists:\n%#v", out.KeyMetadata)
+	}
+
+	return nil
+}
+
+func testAccAWSKmsExternalKeyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSKmsExternalKeyConfigKeyMaterial(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enabled                 = %[2]t
+  key_material_base64     = "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="
+}
+`, rName, enabled)
+}
+
+func testAccAWSKmsExternalKeyConfigValidTo(rName, validTo string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enabled                 = true
+  key_material_base64     = "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="
+  valid_to                = %[2]q
+}
+`, rName, validTo)
+}
@@ -23,7 +23,8 @@ func resourceAwsKmsGrant() *schema.Resource {
 		Create: resourceAwsKmsGrantCreate,
 		Read:   resourceAwsKmsGrantRead,
 		Delete: resourceAwsKmsGrantDelete,
-		Exists: resourceAwsKmsGrantExists,
+
+		CustomizeDiff: resourceAwsKmsGrantCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -75,14 +76,14 @@ func resourceAwsKmsGrant() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
-							// ConflictsWith encryption_context_subset handled in Create, see kmsGrantConstraintsIsValid
+							// ConflictsWith encryption_context_subset handled in CustomizeDiff, see kmsGrantConstraintsIsValid
 						},
 						"encryption_context_subset": {
 							Type:     schema.TypeMap,
 							Optional: true,
 							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
-							// ConflictsWith encryption_context_equals handled in Create, see kmsGrantConstraintsIsValid
+							// ConflictsWith encryption_context_equals handled in CustomizeDiff, see kmsGrantConstraintsIsValid
 						},
 					},
 				},
@@ -131,9 +132,6 @@ func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Name = aws.String(v.(string))
 	}
 	if v, ok := d.GetOk("constraints"); ok {
-		if !kmsGrantConstraintsIsValid(v.(*schema.Set)) {
-			return fmt.Errorf("[ERROR] A grant constraint can't have both encryption_context_equals and encryption_context_subset set")
-		}
 		input.Constraints = expandKmsGrantConstraints(v.(*schema.Set))
 	}
 	if v, ok := d.GetOk("retiring_principal"); ok {
@@ -190,7 +188,20 @@ func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Looking for grant id: %s", grantId)
-	grant, err := findKmsGrantByIdWithRetry(conn, keyId, grantId)
+	var grant *kms.GrantListEntry
+	if d.IsNewResource() {
+		// Newly created grants are eventually consistent, so retry until they are listed
+		grant, err = findKmsGrantByIdWithRetry(conn, keyId, grantId)
+	} else {
+		grant, err = findKmsGrantById(conn, keyId, grantId, nil)
+	}
+
+	if _, ok := err.(KmsGrantMissingError); ok && !d.IsNewResource() {
+		// The grant was retired or revoked outside of Terraform
+		log.Printf("[WARN] %s KMS grant id not found for key id %s, removing from state file", grantId, keyId)
+		d.SetId("")
+		return nil
+	}
 
 	if err != nil {
 		return err
@@ -282,25 +293,14 @@ func resourceAwsKmsGrantDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAwsKmsGrantExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).kmsconn
-
-	keyId, grantId, err := decodeKmsGrantId(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	log.Printf("[DEBUG] Looking for Grant: %s", grantId)
-	grant, err := findKmsGrantByIdWithRetry(conn, keyId, grantId)
-
-	if err != nil {
-		return true, err
-	}
-	if grant != nil {
-		return true, err
+func resourceAwsKmsGrantCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if v, ok := diff.GetOk("constraints"); ok {
+		if !kmsGrantConstraintsIsValid(v.(*schema.Set)) {
+			return fmt.Errorf("a grant constraint can't have both encryption_context_equals and encryption_context_subset set")
+		}
 	}
 
-	return false, nil
+	return nil
 }
 
 func getKmsGrantById(grants []*kms.GrantListEntry, grantIdentifier string) *kms.GrantListEntry {
@@ -393,6 +393,10 @@ func findKmsGrantById(conn *kms.KMS, keyId string, grantId string, marker *strin
 
 		return nil
 	})
+	if isAWSErr(err, kms.ErrCodeNotFoundException, "") {
+		// The key itself no longer exists, so neither does the grant
+		return nil, NewKmsGrantMissingError(fmt.Sprintf("[DEBUG] Key %s not found while looking for grant id: %s", keyId, grantId))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error listing KMS Grants: %s", err)
 	}
@@ -548,7 +552,7 @@ func decodeKmsGrantId(id string) (string, string, error) {
 type KmsGrantMissingError string
 
 func (e KmsGrantMissingError) Error() string {
-	return e.Error()
+	return string(e)
 }
 
 func NewKmsGrantMissingError(msg string) KmsGrantMissingError {
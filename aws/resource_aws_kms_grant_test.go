@@ -2,9 +2,12 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -69,6 +72,22 @@ func TestAWSKmsGrant_withConstraints(t *testing.T) {
 	})
 }
 
+func TestAWSKmsGrant_withConstraintsConflict(t *testing.T) {
+	timestamp := time.Now().Format(time.RFC1123)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSKmsGrant_withConstraintsConflict("withConstraintsConflict", timestamp),
+				ExpectError: regexp.MustCompile(`can't have both encryption_context_equals and encryption_context_subset set`),
+			},
+		},
+	})
+}
+
 func TestAWSKmsGrant_withRetiringPrincipal(t *testing.T) {
 	timestamp := time.Now().Format(time.RFC1123)
 
@@ -133,6 +152,26 @@ func TestAWSKmsGrant_ARN(t *testing.T) {
 	})
 }
 
+func TestAWSKmsGrant_disappears(t *testing.T) {
+	timestamp := time.Now().Format(time.RFC1123)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsGrant_withRetiringPrincipal("disappears", timestamp),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsGrantExists("aws_kms_grant.disappears"),
+					testAccCheckAWSKmsGrantRetire("aws_kms_grant.disappears"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAWSKmsGrantDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).kmsconn
 
@@ -163,6 +202,31 @@ func testAccCheckAWSKmsGrantExists(name string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAWSKmsGrantRetire(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		keyId, grantId, err := decodeKmsGrantId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		_, err = conn.RevokeGrant(&kms.RevokeGrantInput{
+			GrantId: aws.String(grantId),
+			KeyId:   aws.String(keyId),
+		})
+		if err != nil {
+			return err
+		}
+
+		return waitForKmsGrantToBeRevoked(conn, keyId, grantId)
+	}
+}
+
 func testAccAWSKmsGrant_Basic(rName string, timestamp string, operations string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "tf-acc-test-key" {
@@ -216,6 +280,38 @@ resource "aws_kms_grant" "%s" {
 `, timestamp, staticAssumeRolePolicyString, rName, rName, rName, constraintName, encryptionContext)
 }
 
+func testAccAWSKmsGrant_withConstraintsConflict(rName string, timestamp string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "tf-acc-test-key" {
+    description = "Terraform acc test key %s"
+    deletion_window_in_days = 7
+}
+
+%s
+
+resource "aws_iam_role" "tf-acc-test-role" {
+  name               = "tf-acc-test-kms-grant-role-%s"
+  path               = "/service-role/"
+  assume_role_policy = "${data.aws_iam_policy_document.assumerole-policy-template.json}"
+}
+
+resource "aws_kms_grant" "%s" {
+	name = "%s"
+	key_id = "${aws_kms_key.tf-acc-test-key.key_id}"
+	grantee_principal = "${aws_iam_role.tf-acc-test-role.arn}"
+	operations = [ "RetireGrant", "DescribeKey" ]
+	constraints {
+		encryption_context_equals {
+			foo = "bar"
+		}
+		encryption_context_subset {
+			baz = "kaz"
+		}
+	}
+}
+`, timestamp, staticAssumeRolePolicyString, rName, rName, rName)
+}
+
 func testAccAWSKmsGrant_withRetiringPrincipal(rName string, timestamp string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "tf-acc-test-key" {
@@ -92,7 +92,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
-	if v, exists := d.GetOk("tags"); exists {
+	if v, exists := d.GetOk("tags_all"); exists {
 		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
 	}
 
@@ -433,11 +433,17 @@ func resourceAwsKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kmsconn
 	keyId := d.Get("key_id").(string)
 
+	return deleteKmsKey(conn, keyId, d.Get("deletion_window_in_days").(int))
+}
+
+// deleteKmsKey schedules the deletion of the KMS key and waits for it to be
+// pending deletion. A zero pendingWindowInDays uses the KMS default.
+func deleteKmsKey(conn *kms.KMS, keyId string, pendingWindowInDays int) error {
 	req := &kms.ScheduleKeyDeletionInput{
 		KeyId: aws.String(keyId),
 	}
-	if v, exists := d.GetOk("deletion_window_in_days"); exists {
-		req.PendingWindowInDays = aws.Int64(int64(v.(int)))
+	if pendingWindowInDays > 0 {
+		req.PendingWindowInDays = aws.Int64(int64(pendingWindowInDays))
 	}
 	_, err := conn.ScheduleKeyDeletion(req)
 	if err != nil {
@@ -446,8 +452,8 @@ func resourceAwsKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 
 	// Wait for propagation since KMS is eventually consistent
 	wait := resource.StateChangeConf{
-		Pending:                   []string{"Enabled", "Disabled"},
-		Target:                    []string{"PendingDeletion"},
+		Pending:                   []string{kms.KeyStateEnabled, kms.KeyStateDisabled, kms.KeyStatePendingImport},
+		Target:                    []string{kms.KeyStatePendingDeletion},
 		Timeout:                   20 * time.Minute,
 		MinTimeout:                2 * time.Second,
 		ContinuousTargetOccurence: 10,
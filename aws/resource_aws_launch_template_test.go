@@ -227,6 +227,32 @@ func TestAccAWSLaunchTemplate_nonBurstable(t *testing.T) {
 	})
 }
 
+func TestAccAWSLaunchTemplate_instanceMarketOptions(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_instanceMarketOptions(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.#", "1"),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.0.market_type", "spot"),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.0.spot_options.#", "1"),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.0.spot_options.0.instance_interruption_behavior", "stop"),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.0.spot_options.0.max_price", "0.05"),
+					resource.TestCheckResourceAttr(resName, "instance_market_options.0.spot_options.0.spot_instance_type", "persistent"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSLaunchTemplate_networkInterface(t *testing.T) {
 	var template ec2.LaunchTemplate
 	resName := "aws_launch_template.test"
@@ -506,6 +532,25 @@ resource "aws_launch_template" "foo" {
 }
 `
 
+func testAccAWSLaunchTemplateConfig_instanceMarketOptions(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name          = "instance_market_options_%d"
+  instance_type = "t2.micro"
+
+  instance_market_options {
+    market_type = "spot"
+
+    spot_options {
+      instance_interruption_behavior = "stop"
+      max_price                      = "0.05"
+      spot_instance_type             = "persistent"
+    }
+  }
+}
+`, rInt)
+}
+
 const testAccAWSLaunchTemplateConfig_networkInterface = `
 resource "aws_vpc" "test" {
   cidr_block = "10.1.0.0/16"
@@ -76,6 +76,10 @@ func resourceAwsNeptuneParameterGroup() *schema.Resource {
 					},
 				},
 			},
+			"reset_unmanaged_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -256,7 +260,13 @@ func resourceAwsNeptuneParameterGroupUpdate(d *schema.ResourceData, meta interfa
 		d.SetPartial("parameter")
 	}
 
-	if d.HasChange("tags") {
+	if d.Get("reset_unmanaged_parameters").(bool) && (d.HasChange("parameter") || d.HasChange("reset_unmanaged_parameters")) {
+		if err := resetAwsNeptuneParameterGroupUnmanagedParameters(conn, d.Get("name").(string), d.Get("parameter").(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
 		err := setTagsNeptune(conn, d, d.Get("arn").(string))
 		if err != nil {
 			return fmt.Errorf("error setting Neptune Parameter Group %q tags: %s", d.Id(), err)
@@ -289,3 +299,71 @@ func resourceAwsNeptuneParameterGroupDelete(d *schema.ResourceData, meta interfa
 		return nil
 	})
 }
+
+// resetAwsNeptuneParameterGroupUnmanagedParameters resets every user-modified
+// parameter in the group that isn't present in the configured set, so that
+// parameters changed outside of Terraform are returned to their defaults.
+func resetAwsNeptuneParameterGroupUnmanagedParameters(conn *neptune.Neptune, name string, configured *schema.Set) error {
+	managed := make(map[string]bool)
+	for _, v := range configured.List() {
+		managed[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	var unmanaged []*neptune.Parameter
+	err := conn.DescribeDBParametersPages(&neptune.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(name),
+		Source:               aws.String("user"),
+	}, func(page *neptune.DescribeDBParametersOutput, lastPage bool) bool {
+		for _, param := range page.Parameters {
+			if managed[aws.StringValue(param.ParameterName)] {
+				continue
+			}
+
+			applyMethod := neptune.ApplyMethodPendingReboot
+			if aws.StringValue(param.ApplyType) == "dynamic" {
+				applyMethod = neptune.ApplyMethodImmediate
+			}
+
+			unmanaged = append(unmanaged, &neptune.Parameter{
+				ApplyMethod:   aws.String(applyMethod),
+				ParameterName: param.ParameterName,
+			})
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Neptune Parameter Group (%s) parameters: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Unmanaged parameters to reset: %#v", unmanaged)
+
+	for len(unmanaged) > 0 {
+		var paramsToReset []*neptune.Parameter
+		if len(unmanaged) <= maxParams {
+			paramsToReset, unmanaged = unmanaged[:], nil
+		} else {
+			paramsToReset, unmanaged = unmanaged[:maxParams], unmanaged[maxParams:]
+		}
+		resetOpts := neptune.ResetDBParameterGroupInput{
+			DBParameterGroupName: aws.String(name),
+			Parameters:           paramsToReset,
+		}
+
+		log.Printf("[DEBUG] Reset Neptune Parameter Group: %s", resetOpts)
+		err := resource.Retry(30*time.Second, func() *resource.RetryError {
+			_, err := conn.ResetDBParameterGroup(&resetOpts)
+			if err != nil {
+				if isAWSErr(err, "InvalidDBParameterGroupState", " has pending changes") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error resetting Neptune Parameter Group: %s", err)
+		}
+	}
+
+	return nil
+}
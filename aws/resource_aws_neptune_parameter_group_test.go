@@ -115,6 +115,36 @@ func TestAccAWSNeptuneParameterGroup_Parameter(t *testing.T) {
 	})
 }
 
+func TestAccAWSNeptuneParameterGroup_ResetUnmanagedParameters(t *testing.T) {
+	var v neptune.DBParameterGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_neptune_parameter_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneParameterGroupConfig_ResetUnmanagedParameters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneParameterGroupExists(resourceName, &v),
+					testAccCheckAWSNeptuneParameterGroupModifyParameter(&v, "neptune_query_timeout", "25"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSNeptuneParameterGroupConfig_ResetUnmanagedParameters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "reset_unmanaged_parameters", "true"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSNeptuneParameterGroup_Tags(t *testing.T) {
 	var v neptune.DBParameterGroup
 
@@ -160,6 +190,24 @@ func TestAccAWSNeptuneParameterGroup_Tags(t *testing.T) {
 	})
 }
 
+func testAccCheckAWSNeptuneParameterGroupModifyParameter(v *neptune.DBParameterGroup, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+
+		_, err := conn.ModifyDBParameterGroup(&neptune.ModifyDBParameterGroupInput{
+			DBParameterGroupName: v.DBParameterGroupName,
+			Parameters: []*neptune.Parameter{
+				{
+					ApplyMethod:    aws.String(neptune.ApplyMethodPendingReboot),
+					ParameterName:  aws.String(name),
+					ParameterValue: aws.String(value),
+				},
+			},
+		})
+		return err
+	}
+}
+
 func testAccCheckAWSNeptuneParameterGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
 
@@ -269,6 +317,15 @@ resource "aws_neptune_parameter_group" "test" {
 }`, rName)
 }
 
+func testAccAWSNeptuneParameterGroupConfig_ResetUnmanagedParameters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_parameter_group" "test" {
+  family                     = "neptune1"
+  name                       = %q
+  reset_unmanaged_parameters = true
+}`, rName)
+}
+
 func testAccAWSNeptuneParameterGroupConfig_Tags_SingleTag(name, tKey, tValue string) string {
 	return fmt.Sprintf(`
 resource "aws_neptune_parameter_group" "test" {
@@ -17,6 +17,7 @@ func resourceAwsOrganizationsAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsOrganizationsAccountCreate,
 		Read:   resourceAwsOrganizationsAccountRead,
+		Update: resourceAwsOrganizationsAccountUpdate,
 		Delete: resourceAwsOrganizationsAccountDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -51,6 +52,12 @@ func resourceAwsOrganizationsAccount() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validateAwsOrganizationsAccountEmail,
 			},
+			"parent_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAwsOrganizationsParentId,
+			},
 			"iam_user_access_to_billing": {
 				ForceNew:     true,
 				Type:         schema.TypeString,
@@ -130,6 +137,17 @@ func resourceAwsOrganizationsAccountCreate(d *schema.ResourceData, meta interfac
 	accountId := stateResp.(*organizations.CreateAccountStatus).AccountId
 	d.SetId(*accountId)
 
+	if v, ok := d.GetOk("parent_id"); ok {
+		currentParentId, err := getOrganizationsParentId(conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error reading AWS account (%s) parent: %s", d.Id(), err)
+		}
+
+		if err := moveOrganizationsAccount(conn, d.Id(), currentParentId, v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsOrganizationsAccountRead(d, meta)
 }
 
@@ -155,15 +173,35 @@ func resourceAwsOrganizationsAccountRead(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
+	parentId, err := getOrganizationsParentId(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading AWS account (%s) parent: %s", d.Id(), err)
+	}
+
 	d.Set("arn", account.Arn)
 	d.Set("email", account.Email)
 	d.Set("joined_method", account.JoinedMethod)
 	d.Set("joined_timestamp", account.JoinedTimestamp)
 	d.Set("name", account.Name)
+	d.Set("parent_id", parentId)
 	d.Set("status", account.Status)
 	return nil
 }
 
+func resourceAwsOrganizationsAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("parent_id") {
+		o, n := d.GetChange("parent_id")
+
+		if err := moveOrganizationsAccount(conn, d.Id(), o.(string), n.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsOrganizationsAccountRead(d, meta)
+}
+
 func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).organizationsconn
 
@@ -181,6 +219,25 @@ func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+func moveOrganizationsAccount(conn *organizations.Organizations, accountId, sourceParentId, destinationParentId string) error {
+	if sourceParentId == destinationParentId {
+		return nil
+	}
+
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(accountId),
+		DestinationParentId: aws.String(destinationParentId),
+		SourceParentId:      aws.String(sourceParentId),
+	}
+
+	log.Printf("[DEBUG] Moving AWS account: %s", input)
+	if _, err := conn.MoveAccount(input); err != nil {
+		return fmt.Errorf("error moving AWS account (%s) to %s: %s", accountId, destinationParentId, err)
+	}
+
+	return nil
+}
+
 // resourceAwsOrganizationsAccountStateRefreshFunc returns a resource.StateRefreshFunc
 // that is used to watch a CreateAccount request
 func resourceAwsOrganizationsAccountStateRefreshFunc(conn *organizations.Organizations, id string) resource.StateRefreshFunc {
@@ -206,7 +263,7 @@ func resourceAwsOrganizationsAccountStateRefreshFunc(conn *organizations.Organiz
 
 		accountStatus := resp.CreateAccountStatus
 		if *accountStatus.State == organizations.CreateAccountStateFailed {
-			return nil, *accountStatus.State, fmt.Errorf(*accountStatus.FailureReason)
+			return nil, *accountStatus.State, fmt.Errorf("%s", aws.StringValue(accountStatus.FailureReason))
 		}
 		return accountStatus, *accountStatus.State, nil
 	}
@@ -36,6 +36,26 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"roots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"feature_set": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -88,6 +108,16 @@ func resourceAwsOrganizationsOrganizationRead(d *schema.ResourceData, meta inter
 	d.Set("master_account_arn", org.Organization.MasterAccountArn)
 	d.Set("master_account_email", org.Organization.MasterAccountEmail)
 	d.Set("master_account_id", org.Organization.MasterAccountId)
+
+	roots, err := listOrganizationsRoots(conn)
+	if err != nil {
+		return fmt.Errorf("error listing Organization roots: %s", err)
+	}
+
+	if err := d.Set("roots", flattenOrganizationsRoots(roots)); err != nil {
+		return fmt.Errorf("error setting roots: %s", err)
+	}
+
 	return nil
 }
 
@@ -103,3 +133,27 @@ func resourceAwsOrganizationsOrganizationDelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+func listOrganizationsRoots(conn *organizations.Organizations) ([]*organizations.Root, error) {
+	var roots []*organizations.Root
+
+	err := conn.ListRootsPages(&organizations.ListRootsInput{}, func(page *organizations.ListRootsOutput, lastPage bool) bool {
+		roots = append(roots, page.Roots...)
+		return !lastPage
+	})
+
+	return roots, err
+}
+
+func flattenOrganizationsRoots(roots []*organizations.Root) []interface{} {
+	result := make([]interface{}, 0, len(roots))
+	for _, root := range roots {
+		result = append(result, map[string]interface{}{
+			"arn":  aws.StringValue(root.Arn),
+			"id":   aws.StringValue(root.Id),
+			"name": aws.StringValue(root.Name),
+		})
+	}
+
+	return result
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/organizations"
@@ -26,6 +27,8 @@ func testAccAwsOrganizationsOrganization_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "master_account_arn"),
 					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "master_account_email"),
 					resource.TestCheckResourceAttrSet("aws_organizations_organization.test", "feature_set"),
+					resource.TestCheckResourceAttr("aws_organizations_organization.test", "roots.#", "1"),
+					resource.TestMatchResourceAttr("aws_organizations_organization.test", "roots.0.id", regexp.MustCompile(`^r-[0-9a-z]+$`)),
 				),
 			},
 		},
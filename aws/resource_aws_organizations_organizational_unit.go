@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsOrganizationsOrganizationalUnit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsOrganizationalUnitCreate,
+		Read:   resourceAwsOrganizationsOrganizationalUnitRead,
+		Update: resourceAwsOrganizationsOrganizationalUnitUpdate,
+		Delete: resourceAwsOrganizationsOrganizationalUnitDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsOrganizationsParentId,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsOrganizationalUnitCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.CreateOrganizationalUnitInput{
+		Name:     aws.String(d.Get("name").(string)),
+		ParentId: aws.String(d.Get("parent_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Organizations Organizational Unit: %s", input)
+	output, err := conn.CreateOrganizationalUnit(input)
+	if err != nil {
+		return fmt.Errorf("error creating Organizations Organizational Unit: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.OrganizationalUnit.Id))
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Organizations Organizational Unit: %s", input)
+	output, err := conn.DescribeOrganizationalUnit(input)
+	if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+		log.Printf("[WARN] Organizations Organizational Unit (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.OrganizationalUnit == nil {
+		log.Printf("[WARN] Organizations Organizational Unit (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	parentId, err := getOrganizationsParentId(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Organizational Unit (%s) parent: %s", d.Id(), err)
+	}
+
+	accounts, err := listOrganizationsAccountsForParent(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Organizational Unit (%s) accounts: %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.OrganizationalUnit.Arn)
+	d.Set("name", output.OrganizationalUnit.Name)
+	d.Set("parent_id", parentId)
+
+	if err := d.Set("accounts", flattenOrganizationsAccounts(accounts)); err != nil {
+		return fmt.Errorf("error setting accounts: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsOrganizationsOrganizationalUnitUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("name") {
+		input := &organizations.UpdateOrganizationalUnitInput{
+			Name:                 aws.String(d.Get("name").(string)),
+			OrganizationalUnitId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Organizations Organizational Unit: %s", input)
+		if _, err := conn.UpdateOrganizationalUnit(input); err != nil {
+			return fmt.Errorf("error updating Organizations Organizational Unit (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsOrganizationsOrganizationalUnitRead(d, meta)
+}
+
+func resourceAwsOrganizationsOrganizationalUnitDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.DeleteOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Organizations Organizational Unit: %s", input)
+	_, err := conn.DeleteOrganizationalUnit(input)
+	if isAWSErr(err, organizations.ErrCodeOrganizationalUnitNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Organizations Organizational Unit (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getOrganizationsParentId returns the ID of the root or organizational unit
+// directly containing the given account or organizational unit.
+func getOrganizationsParentId(conn *organizations.Organizations, childId string) (string, error) {
+	input := &organizations.ListParentsInput{
+		ChildId: aws.String(childId),
+	}
+
+	output, err := conn.ListParents(input)
+	if err != nil {
+		return "", err
+	}
+
+	// An account or organizational unit can only have a single parent
+	if output == nil || len(output.Parents) == 0 {
+		return "", fmt.Errorf("no parent found for %s", childId)
+	}
+
+	return aws.StringValue(output.Parents[0].Id), nil
+}
+
+func listOrganizationsAccountsForParent(conn *organizations.Organizations, parentId string) ([]*organizations.Account, error) {
+	var accounts []*organizations.Account
+
+	input := &organizations.ListAccountsForParentInput{
+		ParentId: aws.String(parentId),
+	}
+
+	err := conn.ListAccountsForParentPages(input, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+		accounts = append(accounts, page.Accounts...)
+		return !lastPage
+	})
+
+	return accounts, err
+}
+
+func flattenOrganizationsAccounts(accounts []*organizations.Account) []interface{} {
+	result := make([]interface{}, 0, len(accounts))
+	for _, account := range accounts {
+		result = append(result, map[string]interface{}{
+			"arn":   aws.StringValue(account.Arn),
+			"email": aws.StringValue(account.Email),
+			"id":    aws.StringValue(account.Id),
+			"name":  aws.StringValue(account.Name),
+		})
+	}
+
+	return result
+}
+
+func validateAwsOrganizationsParentId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[0-9a-z]{8,32})$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be the ID of a root (r-xxxx) or an organizational unit (ou-xxxx-xxxxxxxx), got: %s", k, value))
+	}
+
+	return
+}
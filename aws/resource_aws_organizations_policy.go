@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,13 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// These policy types are not yet defined in the vendored AWS SDK
+const (
+	organizationsPolicyTypeAiservicesOptOutPolicy = "AISERVICES_OPT_OUT_POLICY"
+	organizationsPolicyTypeBackupPolicy           = "BACKUP_POLICY"
+	organizationsPolicyTypeTagPolicy              = "TAG_POLICY"
+)
+
 func resourceAwsOrganizationsPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsOrganizationsPolicyCreate,
@@ -22,6 +30,8 @@ func resourceAwsOrganizationsPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsOrganizationsPolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -30,7 +40,7 @@ func resourceAwsOrganizationsPolicy() *schema.Resource {
 			"content": {
 				Type:             schema.TypeString,
 				Required:         true,
-				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				DiffSuppressFunc: suppressEquivalentOrganizationsPolicyContentDiffs,
 				ValidateFunc:     validateJsonString,
 			},
 			"description": {
@@ -48,6 +58,9 @@ func resourceAwsOrganizationsPolicy() *schema.Resource {
 				Default:  organizations.PolicyTypeServiceControlPolicy,
 				ValidateFunc: validation.StringInSlice([]string{
 					organizations.PolicyTypeServiceControlPolicy,
+					organizationsPolicyTypeAiservicesOptOutPolicy,
+					organizationsPolicyTypeBackupPolicy,
+					organizationsPolicyTypeTagPolicy,
 				}, false),
 			},
 		},
@@ -172,3 +185,57 @@ func resourceAwsOrganizationsPolicyDelete(d *schema.ResourceData, meta interface
 	}
 	return nil
 }
+
+func resourceAwsOrganizationsPolicyCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("content") || !diff.NewValueKnown("type") {
+		return nil
+	}
+
+	return validateOrganizationsPolicyContent(diff.Get("type").(string), diff.Get("content").(string))
+}
+
+// validateOrganizationsPolicyContent checks that the policy content has the
+// top level structure expected by the given policy type.
+func validateOrganizationsPolicyContent(policyType, content string) error {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &m); err != nil {
+		return fmt.Errorf("content must be a JSON object: %s", err)
+	}
+
+	var requiredKey string
+	switch policyType {
+	case organizations.PolicyTypeServiceControlPolicy:
+		requiredKey = "Statement"
+	case organizationsPolicyTypeAiservicesOptOutPolicy:
+		requiredKey = "services"
+	case organizationsPolicyTypeBackupPolicy:
+		requiredKey = "plans"
+	case organizationsPolicyTypeTagPolicy:
+		requiredKey = "tags"
+	default:
+		return nil
+	}
+
+	if _, ok := m[requiredKey]; !ok {
+		return fmt.Errorf("content of a %s must contain a top level %q key", policyType, requiredKey)
+	}
+
+	// Only service control policies use the IAM policy language
+	if policyType != organizations.PolicyTypeServiceControlPolicy {
+		if _, ok := m["Statement"]; ok {
+			return fmt.Errorf("content of a %s must not contain IAM policy statements", policyType)
+		}
+	}
+
+	return nil
+}
+
+// suppressEquivalentOrganizationsPolicyContentDiffs compares service control
+// policies as IAM policies and all other policy types as plain JSON.
+func suppressEquivalentOrganizationsPolicyContentDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("type").(string) == organizations.PolicyTypeServiceControlPolicy {
+		return suppressEquivalentAwsPolicyDiffs(k, old, new, d)
+	}
+
+	return suppressEquivalentJsonDiffs(k, old, new, d)
+}
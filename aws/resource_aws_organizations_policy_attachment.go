@@ -81,8 +81,29 @@ func resourceAwsOrganizationsPolicyAttachmentRead(d *schema.ResourceData, meta i
 		return err
 	}
 
+	// ListPoliciesForTarget only returns policies of a single type
+	describePolicyInput := &organizations.DescribePolicyInput{
+		PolicyId: aws.String(policyID),
+	}
+
+	log.Printf("[DEBUG] Reading Organizations Policy: %s", describePolicyInput)
+	describePolicyOutput, err := conn.DescribePolicy(describePolicyInput)
+	if isAWSErr(err, organizations.ErrCodePolicyNotFoundException, "") {
+		log.Printf("[WARN] Policy does not exist, removing from state: %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Policy (%s): %s", policyID, err)
+	}
+
+	policyType := organizations.PolicyTypeServiceControlPolicy
+	if describePolicyOutput.Policy != nil && describePolicyOutput.Policy.PolicySummary != nil {
+		policyType = aws.StringValue(describePolicyOutput.Policy.PolicySummary.Type)
+	}
+
 	input := &organizations.ListPoliciesForTargetInput{
-		Filter:   aws.String(organizations.PolicyTypeServiceControlPolicy),
+		Filter:   aws.String(policyType),
 		TargetId: aws.String(targetID),
 	}
 
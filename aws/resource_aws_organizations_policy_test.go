@@ -84,6 +84,64 @@ func TestAccAwsOrganizationsPolicy_description(t *testing.T) {
 	})
 }
 
+func TestAccAwsOrganizationsPolicy_type(t *testing.T) {
+	var policy organizations.Policy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_policy.test"
+	tagPolicyContent := `{"tags": {"costcenter": {"tag_key": {"@@assign": "CostCenter"}}}}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsOrganizationsPolicyConfig_Type(rName, `{"Version": "2012-10-17", "Statement": { "Effect": "Allow", "Action": "*", "Resource": "*"}}`, "TAG_POLICY"),
+				ExpectError: regexp.MustCompile(`must contain a top level "tags" key`),
+			},
+			{
+				Config: testAccAwsOrganizationsPolicyConfig_Type(rName, tagPolicyContent, "TAG_POLICY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "type", "TAG_POLICY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateOrganizationsPolicyContent(t *testing.T) {
+	testCases := []struct {
+		policyType string
+		content    string
+		valid      bool
+	}{
+		{organizations.PolicyTypeServiceControlPolicy, `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`, true},
+		{organizations.PolicyTypeServiceControlPolicy, `{"tags": {}}`, false},
+		{organizationsPolicyTypeTagPolicy, `{"tags": {"costcenter": {"tag_key": {"@@assign": "CostCenter"}}}}`, true},
+		{organizationsPolicyTypeTagPolicy, `{"tags": {}, "Statement": []}`, false},
+		{organizationsPolicyTypeBackupPolicy, `{"plans": {}}`, true},
+		{organizationsPolicyTypeBackupPolicy, `{"tags": {}}`, false},
+		{organizationsPolicyTypeAiservicesOptOutPolicy, `{"services": {"default": {"opt_out_policy": {"@@assign": "optOut"}}}}`, true},
+		{organizationsPolicyTypeAiservicesOptOutPolicy, `[]`, false},
+	}
+
+	for _, tc := range testCases {
+		err := validateOrganizationsPolicyContent(tc.policyType, tc.content)
+		if tc.valid && err != nil {
+			t.Errorf("expected %s content %s to be valid, got: %s", tc.policyType, tc.content, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected %s content %s to be invalid", tc.policyType, tc.content)
+		}
+	}
+}
+
 func testAccCheckAwsOrganizationsPolicyDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
 
@@ -160,3 +218,13 @@ resource "aws_organizations_policy" "test" {
 }
 `, strconv.Quote(content), rName)
 }
+
+func testAccAwsOrganizationsPolicyConfig_Type(rName, content, policyType string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_policy" "test" {
+  content = %s
+  name    = "%s"
+  type    = "%s"
+}
+`, strconv.Quote(content), rName, policyType)
+}
@@ -14,6 +14,11 @@ func TestAccAWSOrganizations(t *testing.T) {
 		"Account": {
 			"basic": testAccAwsOrganizationsAccount_basic,
 		},
+		"OrganizationalUnit": {
+			"basic":              testAccAwsOrganizationsOrganizationalUnit_basic,
+			"DataSourceBasic":    testAccDataSourceAwsOrganizationsOrganizationalUnit_basic,
+			"DataSourceChildren": testAccDataSourceAwsOrganizationsOrganizationalUnits_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -394,7 +394,7 @@ func resourceAwsRdsClusterImport(
 
 func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var identifier string
 	if v, ok := d.GetOk("cluster_identifier"); ok {
@@ -503,7 +503,7 @@ func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error
 			}
 
 			// Wait, catching any errors
-			_, err := stateConf.WaitForState()
+			_, err := waitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster (%s) creation", d.Id()))
 			if err != nil {
 				return err
 			}
@@ -832,7 +832,7 @@ func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForState()
+	_, err := waitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster (%s) creation", d.Id()))
 	if err != nil {
 		return fmt.Errorf("[WARN] Error waiting for RDS Cluster state to be \"available\": %s", err)
 	}
@@ -1060,7 +1060,7 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[INFO] Waiting for RDS Cluster (%s) to modify", d.Id())
-		_, err = stateConf.WaitForState()
+		_, err = waitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster (%s) modification", d.Id()))
 		if err != nil {
 			return fmt.Errorf("error waiting for RDS Cluster (%s) to modify: %s", d.Id(), err)
 		}
@@ -1096,7 +1096,7 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Tags are set on creation
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChange("tags_all") {
 		if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
 			return err
 		} else {
@@ -1155,7 +1155,7 @@ func resourceAwsRDSClusterDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
+	_, err = waitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster (%s) deletion", d.Id()))
 	if err != nil {
 		return fmt.Errorf("[WARN] Error deleting RDS Cluster (%s): %s", d.Id(), err)
 	}
@@ -207,7 +207,7 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 
 func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	createOpts := &rds.CreateDBInstanceInput{
 		DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
@@ -301,7 +301,7 @@ func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
+	_, err = waitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster Instance (%s) creation", d.Id()))
 	if err != nil {
 		return err
 	}
@@ -495,7 +495,7 @@ func resourceAwsRDSClusterInstanceUpdate(d *schema.ResourceData, meta interface{
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = waitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster Instance (%s) modification", d.Id()))
 		if err != nil {
 			return err
 		}
@@ -532,7 +532,7 @@ func resourceAwsRDSClusterInstanceDelete(d *schema.ResourceData, meta interface{
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster Instance (%s) deletion", d.Id())); err != nil {
 		return err
 	}
 
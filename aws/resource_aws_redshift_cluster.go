@@ -25,6 +25,12 @@ func resourceAwsRedshiftCluster() *schema.Resource {
 			State: resourceAwsRedshiftClusterImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"database_name": {
 				Type:         schema.TypeString,
@@ -325,7 +331,7 @@ func resourceAwsRedshiftClusterImport(
 
 func resourceAwsRedshiftClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).redshiftconn
-	tags := tagsFromMapRedshift(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRedshift(d.Get("tags_all").(map[string]interface{}))
 
 	if v, ok := d.GetOk("snapshot_identifier"); ok {
 		restoreOpts := &redshift.RestoreFromClusterSnapshotInput{
@@ -485,7 +491,7 @@ func resourceAwsRedshiftClusterCreate(d *schema.ResourceData, meta interface{})
 		Pending:    []string{"creating", "backing-up", "modifying", "restoring"},
 		Target:     []string{"available"},
 		Refresh:    resourceAwsRedshiftClusterStateRefreshFunc(d.Id(), conn),
-		Timeout:    75 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 	}
 
@@ -649,9 +655,40 @@ func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{})
 		ClusterIdentifier: aws.String(d.Id()),
 	}
 
+	// Changing only the number of nodes of a multi-node cluster uses elastic resize,
+	// which completes in minutes rather than the hours a classic resize takes.
+	elasticResized := false
+	if d.HasChange("number_of_nodes") && !d.HasChange("cluster_type") && !d.HasChange("node_type") {
+		o, n := d.GetChange("number_of_nodes")
+		if o.(int) > 1 && n.(int) > 1 {
+			resizeReq := &redshift.ResizeClusterInput{
+				Classic:           aws.Bool(false),
+				ClusterIdentifier: aws.String(d.Id()),
+				NumberOfNodes:     aws.Int64(int64(n.(int))),
+			}
+
+			log.Printf("[DEBUG] Redshift Cluster elastic resize options: %s", resizeReq)
+			_, err := conn.ResizeCluster(resizeReq)
+			switch {
+			case err == nil:
+				elasticResized = true
+
+				// The cluster rejects further modifications until the resize completes.
+				if err := waitForRedshiftClusterUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Redshift Cluster (%s) elastic resize: %s", d.Id(), err)
+				}
+			case isAWSErr(err, redshift.ErrCodeUnsupportedOperationFault, ""), isAWSErr(err, redshift.ErrCodeUnsupportedOptionFault, ""):
+				// Not every node type and node count supports elastic resize.
+				log.Printf("[DEBUG] Elastic resize of Redshift Cluster (%s) not supported, using classic resize: %s", d.Id(), err)
+			default:
+				return fmt.Errorf("error resizing Redshift Cluster (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	// If the cluster type, node type, or number of nodes changed, then the AWS API expects all three
 	// items to be sent over
-	if d.HasChange("cluster_type") || d.HasChange("node_type") || d.HasChange("number_of_nodes") {
+	if !elasticResized && (d.HasChange("cluster_type") || d.HasChange("node_type") || d.HasChange("number_of_nodes")) {
 		req.ClusterType = aws.String(d.Get("cluster_type").(string))
 		req.NodeType = aws.String(d.Get("node_type").(string))
 		if v := d.Get("number_of_nodes").(int); v > 1 {
@@ -755,18 +792,7 @@ func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if requestUpdate || d.HasChange("iam_roles") {
-
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{"creating", "deleting", "rebooting", "resizing", "renaming", "modifying"},
-			Target:     []string{"available"},
-			Refresh:    resourceAwsRedshiftClusterStateRefreshFunc(d.Id(), conn),
-			Timeout:    40 * time.Minute,
-			MinTimeout: 10 * time.Second,
-		}
-
-		// Wait, catching any errors
-		_, err := stateConf.WaitForState()
-		if err != nil {
+		if err := waitForRedshiftClusterUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("[WARN] Error Modifying Redshift Cluster (%s): %s", d.Id(), err)
 		}
 	}
@@ -895,7 +921,7 @@ func resourceAwsRedshiftClusterDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] Deleting Redshift Cluster: %s", deleteOpts)
-	_, err := deleteAwsRedshiftCluster(&deleteOpts, conn)
+	_, err := deleteAwsRedshiftCluster(&deleteOpts, conn, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -905,7 +931,7 @@ func resourceAwsRedshiftClusterDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func deleteAwsRedshiftCluster(opts *redshift.DeleteClusterInput, conn *redshift.Redshift) (interface{}, error) {
+func deleteAwsRedshiftCluster(opts *redshift.DeleteClusterInput, conn *redshift.Redshift, timeout time.Duration) (interface{}, error) {
 	id := *opts.ClusterIdentifier
 	log.Printf("[INFO] Deleting Redshift Cluster %q", id)
 	err := resource.Retry(15*time.Minute, func() *resource.RetryError {
@@ -925,7 +951,7 @@ func deleteAwsRedshiftCluster(opts *redshift.DeleteClusterInput, conn *redshift.
 		Pending:    []string{"available", "creating", "deleting", "rebooting", "resizing", "renaming", "final-snapshot"},
 		Target:     []string{"destroyed"},
 		Refresh:    resourceAwsRedshiftClusterStateRefreshFunc(id, conn),
-		Timeout:    40 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 	}
 
@@ -1067,3 +1093,17 @@ func validateRedshiftClusterMasterPassword(v interface{}, k string) (ws []string
 	}
 	return
 }
+
+func waitForRedshiftClusterUpdate(conn *redshift.Redshift, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "deleting", "rebooting", "resizing", "renaming", "modifying"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsRedshiftClusterStateRefreshFunc(id, conn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
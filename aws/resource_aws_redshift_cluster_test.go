@@ -399,6 +399,36 @@ func TestAccAWSRedshiftCluster_updateNodeCount(t *testing.T) {
 	})
 }
 
+func TestAccAWSRedshiftCluster_elasticResizeWithOtherChanges(t *testing.T) {
+	var v redshift.Cluster
+	resourceName := "aws_redshift_cluster.default"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRedshiftClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterConfig_elasticResize(ri, 2, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "number_of_nodes", "2"),
+					resource.TestCheckResourceAttr(resourceName, "automated_snapshot_retention_period", "0"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftClusterConfig_elasticResize(ri, 4, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRedshiftClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "number_of_nodes", "4"),
+					resource.TestCheckResourceAttr(resourceName, "automated_snapshot_retention_period", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSRedshiftCluster_updateNodeType(t *testing.T) {
 	var v redshift.Cluster
 
@@ -828,6 +858,23 @@ resource "aws_redshift_cluster" "default" {
 `, rInt)
 }
 
+func testAccAWSRedshiftClusterConfig_elasticResize(rInt, numberOfNodes, retentionPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_cluster" "default" {
+  cluster_identifier = "tf-redshift-cluster-%d"
+  availability_zone = "us-west-2a"
+  database_name = "mydb"
+  master_username = "foo_test"
+  master_password = "Mustbe8characters"
+  node_type = "dc2.large"
+  automated_snapshot_retention_period = %d
+  allow_version_upgrade = false
+  number_of_nodes = %d
+  skip_final_snapshot = true
+}
+`, rInt, retentionPeriod, numberOfNodes)
+}
+
 func testAccAWSRedshiftClusterConfig_updateNodeType(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_redshift_cluster" "default" {
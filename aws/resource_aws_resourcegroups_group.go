@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsResourceGroupsGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsResourceGroupsGroupCreate,
+		Read:   resourceAwsResourceGroupsGroupRead,
+		Update: resourceAwsResourceGroupsGroupUpdate,
+		Delete: resourceAwsResourceGroupsGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"resource_query": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+							ValidateFunc:     validateJsonString,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  resourcegroups.QueryTypeTagFilters10,
+							ValidateFunc: validation.StringInSlice([]string{
+								resourcegroups.QueryTypeTagFilters10,
+							}, false),
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsResourceGroupsGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	input := &resourcegroups.CreateGroupInput{
+		Name:          aws.String(d.Get("name").(string)),
+		ResourceQuery: expandResourceGroupsResourceQuery(d.Get("resource_query").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Resource Groups Group: %s", input)
+	output, err := conn.CreateGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating Resource Groups Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Group.Name))
+
+	return resourceAwsResourceGroupsGroupRead(d, meta)
+}
+
+func resourceAwsResourceGroupsGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	input := &resourcegroups.GetGroupInput{
+		GroupName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Resource Groups Group: %s", input)
+	output, err := conn.GetGroup(input)
+	if isAWSErr(err, resourcegroups.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Resource Groups Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Resource Groups Group (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Group == nil {
+		log.Printf("[WARN] Resource Groups Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(output.Group.GroupArn)
+	d.Set("arn", arn)
+	d.Set("description", output.Group.Description)
+	d.Set("name", output.Group.Name)
+
+	queryOutput, err := conn.GetGroupQuery(&resourcegroups.GetGroupQueryInput{
+		GroupName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Resource Groups Group (%s) resource query: %s", d.Id(), err)
+	}
+
+	if queryOutput.GroupQuery != nil {
+		if err := d.Set("resource_query", flattenResourceGroupsResourceQuery(queryOutput.GroupQuery.ResourceQuery)); err != nil {
+			return fmt.Errorf("error setting resource_query: %s", err)
+		}
+	}
+
+	tagsOutput, err := conn.GetTags(&resourcegroups.GetTagsInput{
+		Arn: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Resource Groups Group (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsResourceGroupsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	if d.HasChange("description") {
+		input := &resourcegroups.UpdateGroupInput{
+			Description: aws.String(d.Get("description").(string)),
+			GroupName:   aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Resource Groups Group: %s", input)
+		if _, err := conn.UpdateGroup(input); err != nil {
+			return fmt.Errorf("error updating Resource Groups Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("resource_query") {
+		input := &resourcegroups.UpdateGroupQueryInput{
+			GroupName:     aws.String(d.Id()),
+			ResourceQuery: expandResourceGroupsResourceQuery(d.Get("resource_query").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Resource Groups Group resource query: %s", input)
+		if _, err := conn.UpdateGroupQuery(input); err != nil {
+			return fmt.Errorf("error updating Resource Groups Group (%s) resource query: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsResourceGroups(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating Resource Groups Group (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsResourceGroupsGroupRead(d, meta)
+}
+
+func resourceAwsResourceGroupsGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	input := &resourcegroups.DeleteGroupInput{
+		GroupName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Resource Groups Group: %s", input)
+	_, err := conn.DeleteGroup(input)
+	if isAWSErr(err, resourcegroups.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Resource Groups Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandResourceGroupsResourceQuery(l []interface{}) *resourcegroups.ResourceQuery {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &resourcegroups.ResourceQuery{
+		Query: aws.String(m["query"].(string)),
+		Type:  aws.String(m["type"].(string)),
+	}
+}
+
+func flattenResourceGroupsResourceQuery(resourceQuery *resourcegroups.ResourceQuery) []interface{} {
+	if resourceQuery == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"query": aws.StringValue(resourceQuery.Query),
+		"type":  aws.StringValue(resourceQuery.Type),
+	}
+
+	return []interface{}{m}
+}
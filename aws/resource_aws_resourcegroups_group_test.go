@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSResourceGroupsGroup_basic(t *testing.T) {
+	resourceName := "aws_resourcegroups_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	query1 := `{
+  "ResourceTypeFilters": [
+    "AWS::EC2::Instance"
+  ],
+  "TagFilters": [
+    {
+      "Key": "Stage",
+      "Values": ["Test"]
+    }
+  ]
+}`
+
+	query2 := `{
+  "ResourceTypeFilters": [
+    "AWS::EC2::Instance"
+  ],
+  "TagFilters": [
+    {
+      "Key": "Hello",
+      "Values": ["World"]
+    }
+  ]
+}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceGroupsGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceGroupsGroupConfig(rName, "Hello World", query1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceGroupsGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Hello World"),
+					resource.TestCheckResourceAttr(resourceName, "resource_query.0.query", query1+"\n"),
+					resource.TestCheckResourceAttr(resourceName, "resource_query.0.type", "TAG_FILTERS_1_0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSResourceGroupsGroupConfig(rName, "Hello World Updated", query2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceGroupsGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Hello World Updated"),
+					resource.TestCheckResourceAttr(resourceName, "resource_query.0.query", query2+"\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSResourceGroupsGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No resource group name is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+
+		_, err := conn.GetGroup(&resourcegroups.GetGroupInput{
+			GroupName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSResourceGroupsGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resourcegroups_group" {
+			continue
+		}
+
+		_, err := conn.GetGroup(&resourcegroups.GetGroupInput{
+			GroupName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, resourcegroups.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Resource Groups Group (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSResourceGroupsGroupConfig(rName, desc, query string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  resource_query {
+    query = <<JSON
+%[3]s
+JSON
+  }
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, desc, query)
+}
@@ -661,7 +661,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("bucket", d.Id())
 	}
 
-	d.Set("bucket_domain_name", bucketDomainName(d.Get("bucket").(string)))
+	d.Set("bucket_domain_name", bucketDomainName(d.Get("bucket").(string), meta.(*AWSClient).region))
 
 	// Read the policy
 	if _, ok := d.GetOk("policy"); ok {
@@ -1460,8 +1460,8 @@ func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData) (*S3Website, error)
 	return WebsiteEndpoint(bucket, region), nil
 }
 
-func bucketDomainName(bucket string) string {
-	return fmt.Sprintf("%s.s3.amazonaws.com", bucket)
+func bucketDomainName(bucket string, region string) string {
+	return fmt.Sprintf("%s.s3.%s", bucket, partitionDnsSuffix(region))
 }
 
 // https://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region
@@ -1471,7 +1471,7 @@ func BucketRegionalDomainName(bucket string, region string) (string, error) {
 	if region == "" {
 		return fmt.Sprintf("%s.s3.amazonaws.com", bucket), nil
 	}
-	endpoint, err := endpoints.DefaultResolver().EndpointFor(endpoints.S3ServiceID, region)
+	endpoint, err := isolatedPartitionEndpointResolver(endpoints.S3ServiceID, region)
 	if err != nil {
 		return "", err
 	}
@@ -1489,9 +1489,9 @@ func WebsiteDomainUrl(region string) string {
 	// New regions uses different syntax for website endpoints
 	// http://docs.aws.amazon.com/AmazonS3/latest/dev/WebsiteEndpoints.html
 	if isOldRegion(region) {
-		return fmt.Sprintf("s3-website-%s.amazonaws.com", region)
+		return fmt.Sprintf("s3-website-%s.%s", region, partitionDnsSuffix(region))
 	}
-	return fmt.Sprintf("s3-website.%s.amazonaws.com", region)
+	return fmt.Sprintf("s3-website.%s.%s", region, partitionDnsSuffix(region))
 }
 
 func isOldRegion(region string) bool {
@@ -1024,6 +1024,38 @@ func TestAWSS3BucketName(t *testing.T) {
 	}
 }
 
+func TestBucketDomainName(t *testing.T) {
+	const bucket = "bucket-name"
+
+	var testCases = []struct {
+		Region         string
+		ExpectedOutput string
+	}{
+		{
+			Region:         "us-west-2",
+			ExpectedOutput: bucket + ".s3.amazonaws.com",
+		},
+		{
+			Region:         "cn-north-1",
+			ExpectedOutput: bucket + ".s3.amazonaws.com.cn",
+		},
+		{
+			Region:         "us-iso-east-1",
+			ExpectedOutput: bucket + ".s3.c2s.ic.gov",
+		},
+		{
+			Region:         "us-isob-east-1",
+			ExpectedOutput: bucket + ".s3.sc2s.sgov.gov",
+		},
+	}
+
+	for _, tc := range testCases {
+		if output := bucketDomainName(bucket, tc.Region); output != tc.ExpectedOutput {
+			t.Fatalf("expected %q, received %q", tc.ExpectedOutput, output)
+		}
+	}
+}
+
 func TestBucketRegionalDomainName(t *testing.T) {
 	const bucket = "bucket-name"
 
@@ -1062,6 +1094,16 @@ func TestBucketRegionalDomainName(t *testing.T) {
 			ExpectedErrCount: 0,
 			ExpectedOutput:   bucket + ".s3.cn-north-1.amazonaws.com.cn",
 		},
+		{
+			Region:           "us-iso-east-1",
+			ExpectedErrCount: 0,
+			ExpectedOutput:   bucket + ".s3.us-iso-east-1.c2s.ic.gov",
+		},
+		{
+			Region:           "us-isob-east-1",
+			ExpectedErrCount: 0,
+			ExpectedOutput:   bucket + ".s3.us-isob-east-1.sc2s.sgov.gov",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -19,7 +19,7 @@ func resourceAwsSecretsManagerSecret() *schema.Resource {
 		Update: resourceAwsSecretsManagerSecretUpdate,
 		Delete: resourceAwsSecretsManagerSecretDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsSecretsManagerSecretImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -143,23 +143,12 @@ func resourceAwsSecretsManagerSecretCreate(d *schema.ResourceData, meta interfac
 		}
 
 		log.Printf("[DEBUG] Enabling Secrets Manager Secret rotation: %s", input)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.RotateSecret(input)
-			if err != nil {
-				// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
-				if isAWSErr(err, "AccessDeniedException", "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if err != nil {
+		if err := rotateSecretsManagerSecret(conn, input); err != nil {
 			return fmt.Errorf("error enabling Secrets Manager Secret %q rotation: %s", d.Id(), err)
 		}
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input := &secretsmanager.TagResourceInput{
 			SecretId: aws.String(d.Id()),
 			Tags:     tagsFromMapSecretsManager(v.(map[string]interface{})),
@@ -217,14 +206,18 @@ func resourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{
 
 	d.Set("rotation_enabled", output.RotationEnabled)
 
-	if aws.BoolValue(output.RotationEnabled) {
-		d.Set("rotation_lambda_arn", output.RotationLambdaARN)
-		if err := d.Set("rotation_rules", flattenSecretsManagerRotationRules(output.RotationRules)); err != nil {
-			return fmt.Errorf("error setting rotation_rules: %s", err)
+	// Rotation may instead be managed by the aws_secretsmanager_secret_rotation
+	// resource, so only refresh these arguments when this resource manages it.
+	if _, ok := d.GetOk("rotation_lambda_arn"); ok {
+		if aws.BoolValue(output.RotationEnabled) {
+			d.Set("rotation_lambda_arn", output.RotationLambdaARN)
+			if err := d.Set("rotation_rules", flattenSecretsManagerRotationRules(output.RotationRules)); err != nil {
+				return fmt.Errorf("error setting rotation_rules: %s", err)
+			}
+		} else {
+			d.Set("rotation_lambda_arn", "")
+			d.Set("rotation_rules", []interface{}{})
 		}
-	} else {
-		d.Set("rotation_lambda_arn", "")
-		d.Set("rotation_rules", []interface{}{})
 	}
 
 	if err := d.Set("tags", tagsToMapSecretsManager(output.Tags)); err != nil {
@@ -234,6 +227,24 @@ func resourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+func resourceAwsSecretsManagerSecretImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).secretsmanagerconn
+
+	output, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Secrets Manager Secret (%s): %s", d.Id(), err)
+	}
+
+	// Read only refreshes the rotation arguments once they are in state.
+	if aws.BoolValue(output.RotationEnabled) {
+		d.Set("rotation_lambda_arn", output.RotationLambdaARN)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).secretsmanagerconn
 
@@ -292,18 +303,7 @@ func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interfac
 			}
 
 			log.Printf("[DEBUG] Enabling Secrets Manager Secret rotation: %s", input)
-			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-				_, err := conn.RotateSecret(input)
-				if err != nil {
-					// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
-					if isAWSErr(err, "AccessDeniedException", "") {
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
+			if err := rotateSecretsManagerSecret(conn, input); err != nil {
 				return fmt.Errorf("error updating Secrets Manager Secret %q rotation: %s", d.Id(), err)
 			}
 		} else {
@@ -319,8 +319,8 @@ func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsSecretsManager(tagsFromMapSecretsManager(o), tagsFromMapSecretsManager(n))
@@ -381,6 +381,22 @@ func resourceAwsSecretsManagerSecretDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// rotateSecretsManagerSecret configures and starts the rotation of the secret,
+// retrying while the permission to invoke the rotation function propagates.
+func rotateSecretsManagerSecret(conn *secretsmanager.SecretsManager, input *secretsmanager.RotateSecretInput) error {
+	return resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.RotateSecret(input)
+		if err != nil {
+			// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
+			if isAWSErr(err, "AccessDeniedException", "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
 func expandSecretsManagerRotationRules(l []interface{}) *secretsmanager.RotationRulesType {
 	if len(l) == 0 {
 		return nil
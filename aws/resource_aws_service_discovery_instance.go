@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceDiscoveryInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceDiscoveryInstanceCreate,
+		Read:   resourceAwsServiceDiscoveryInstanceRead,
+		Update: resourceAwsServiceDiscoveryInstanceUpdate,
+		Delete: resourceAwsServiceDiscoveryInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attributes": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceDiscoveryInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	serviceID := d.Get("service_id").(string)
+	instanceID := d.Get("instance_id").(string)
+
+	if err := registerServiceDiscoveryInstance(conn, serviceID, instanceID, d.Get("attributes").(map[string]interface{})); err != nil {
+		return fmt.Errorf("error registering Service Discovery Instance (%s): %s", instanceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceID, instanceID))
+
+	return resourceAwsServiceDiscoveryInstanceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	serviceID, instanceID, err := decodeServiceDiscoveryInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicediscovery.GetInstanceInput{
+		InstanceId: aws.String(instanceID),
+		ServiceId:  aws.String(serviceID),
+	}
+
+	log.Printf("[DEBUG] Reading Service Discovery Instance: %s", input)
+	output, err := conn.GetInstance(input)
+	if isAWSErr(err, servicediscovery.ErrCodeInstanceNotFound, "") || isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+		log.Printf("[WARN] Service Discovery Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Service Discovery Instance (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Instance == nil {
+		log.Printf("[WARN] Service Discovery Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", output.Instance.Id)
+	d.Set("service_id", serviceID)
+
+	if err := d.Set("attributes", aws.StringValueMap(output.Instance.Attributes)); err != nil {
+		return fmt.Errorf("error setting attributes: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceDiscoveryInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	serviceID, instanceID, err := decodeServiceDiscoveryInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("attributes") {
+		// Registering an existing instance ID replaces its attributes.
+		if err := registerServiceDiscoveryInstance(conn, serviceID, instanceID, d.Get("attributes").(map[string]interface{})); err != nil {
+			return fmt.Errorf("error updating Service Discovery Instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceDiscoveryInstanceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	serviceID, instanceID, err := decodeServiceDiscoveryInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicediscovery.DeregisterInstanceInput{
+		InstanceId: aws.String(instanceID),
+		ServiceId:  aws.String(serviceID),
+	}
+
+	log.Printf("[DEBUG] Deregistering Service Discovery Instance: %s", input)
+	output, err := conn.DeregisterInstance(input)
+	if isAWSErr(err, servicediscovery.ErrCodeInstanceNotFound, "") || isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deregistering Service Discovery Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForServiceDiscoveryInstanceOperation(conn, aws.StringValue(output.OperationId)); err != nil {
+		return fmt.Errorf("error waiting for Service Discovery Instance (%s) deregistration: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func registerServiceDiscoveryInstance(conn *servicediscovery.ServiceDiscovery, serviceID, instanceID string, attributes map[string]interface{}) error {
+	input := &servicediscovery.RegisterInstanceInput{
+		Attributes:       stringMapToPointers(attributes),
+		CreatorRequestId: aws.String(resource.UniqueId()),
+		InstanceId:       aws.String(instanceID),
+		ServiceId:        aws.String(serviceID),
+	}
+
+	log.Printf("[DEBUG] Registering Service Discovery Instance: %s", input)
+	output, err := conn.RegisterInstance(input)
+	if err != nil {
+		return err
+	}
+
+	return waitForServiceDiscoveryInstanceOperation(conn, aws.StringValue(output.OperationId))
+}
+
+func waitForServiceDiscoveryInstanceOperation(conn *servicediscovery.ServiceDiscovery, operationID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicediscovery.OperationStatusSubmitted, servicediscovery.OperationStatusPending},
+		Target:  []string{servicediscovery.OperationStatusSuccess},
+		Refresh: servicediscoveryOperationRefreshStatusFunc(conn, operationID),
+		Timeout: 5 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func decodeServiceDiscoveryInstanceID(id string) (serviceID, instanceID string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = fmt.Errorf("Service Discovery Instance ID must be of the form <Service ID>/<Instance ID>, was provided: %s", id)
+		return
+	}
+	serviceID = parts[0]
+	instanceID = parts[1]
+	return
+}
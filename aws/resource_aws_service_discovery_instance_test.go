@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceDiscoveryInstance_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_service_discovery_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceDiscoveryInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDiscoveryInstanceConfig(rName, "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceDiscoveryInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", fmt.Sprintf("tf-sd-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "10.0.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.custom_attribute", "custom"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceDiscoveryInstanceConfig(rName, "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceDiscoveryInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "10.0.0.2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceDiscoveryInstanceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sdconn
+
+		serviceID, instanceID, err := decodeServiceDiscoveryInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetInstance(&servicediscovery.GetInstanceInput{
+			InstanceId: aws.String(instanceID),
+			ServiceId:  aws.String(serviceID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsServiceDiscoveryInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sdconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_service_discovery_instance" {
+			continue
+		}
+
+		serviceID, instanceID, err := decodeServiceDiscoveryInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetInstance(&servicediscovery.GetInstanceInput{
+			InstanceId: aws.String(instanceID),
+			ServiceId:  aws.String(serviceID),
+		})
+		if isAWSErr(err, servicediscovery.ErrCodeInstanceNotFound, "") || isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Discovery Instance (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccServiceDiscoveryInstanceConfig(rName, ip string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+  tags {
+    Name = "terraform-testacc-service-discovery-instance"
+  }
+}
+
+resource "aws_service_discovery_private_dns_namespace" "test" {
+  name = "tf-sd-%[1]s.terraform.local"
+  description = "test"
+  vpc = "${aws_vpc.test.id}"
+}
+
+resource "aws_service_discovery_service" "test" {
+  name = "tf-sd-%[1]s"
+  dns_config {
+    namespace_id = "${aws_service_discovery_private_dns_namespace.test.id}"
+    dns_records {
+      ttl = 5
+      type = "A"
+    }
+  }
+}
+
+resource "aws_service_discovery_instance" "test" {
+  instance_id = "tf-sd-%[1]s"
+  service_id = "${aws_service_discovery_service.test.id}"
+
+  attributes = {
+    AWS_INSTANCE_IPV4 = %[2]q
+    custom_attribute = "custom"
+  }
+}
+`, rName, ip)
+}
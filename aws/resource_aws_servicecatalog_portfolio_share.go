@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogPortfolioShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPortfolioShareCreate,
+		Read:   resourceAwsServiceCatalogPortfolioShareRead,
+		Delete: resourceAwsServiceCatalogPortfolioShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPortfolioShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	principalID := d.Get("principal_id").(string)
+
+	input := &servicecatalog.CreatePortfolioShareInput{
+		AcceptLanguage: aws.String("en"),
+		AccountId:      aws.String(principalID),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Portfolio Share: %s", input)
+	if _, err := conn.CreatePortfolioShare(input); err != nil {
+		return fmt.Errorf("error sharing Service Catalog Portfolio (%s) with %s: %s", portfolioID, principalID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", portfolioID, principalID))
+
+	return resourceAwsServiceCatalogPortfolioShareRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, principalID, err := decodeServiceCatalogPortfolioShareID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.ListPortfolioAccessInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	log.Printf("[DEBUG] Listing Service Catalog Portfolio access: %s", input)
+	output, err := conn.ListPortfolioAccess(input)
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Portfolio (%s) not found, removing share from state", portfolioID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing Service Catalog Portfolio (%s) access: %s", portfolioID, err)
+	}
+
+	found := false
+	for _, accountID := range output.AccountIds {
+		if aws.StringValue(accountID) == principalID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Portfolio Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("principal_id", principalID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPortfolioShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, principalID, err := decodeServiceCatalogPortfolioShareID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DeletePortfolioShareInput{
+		AcceptLanguage: aws.String("en"),
+		AccountId:      aws.String(principalID),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Portfolio Share: %s", input)
+	_, err = conn.DeletePortfolioShare(input)
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Portfolio Share (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeServiceCatalogPortfolioShareID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected PORTFOLIO_ID:PRINCIPAL_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
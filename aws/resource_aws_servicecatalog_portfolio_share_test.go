@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogPortfolioShare_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_portfolio_share.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	principalID := "111111111111"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPortfolioShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPortfolioShareConfig(rName, principalID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPortfolioShareExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "principal_id", principalID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogPortfolioShareExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		found, err := testAccAWSServiceCatalogPortfolioShareFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("Service Catalog Portfolio Share (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogPortfolioShareDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_portfolio_share" {
+			continue
+		}
+
+		found, err := testAccAWSServiceCatalogPortfolioShareFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("Service Catalog Portfolio Share (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogPortfolioShareFound(id string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	portfolioID, principalID, err := decodeServiceCatalogPortfolioShareID(id)
+	if err != nil {
+		return false, err
+	}
+
+	output, err := conn.ListPortfolioAccess(&servicecatalog.ListPortfolioAccessInput{
+		PortfolioId: aws.String(portfolioID),
+	})
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, accountID := range output.AccountIds {
+		if aws.StringValue(accountID) == principalID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAWSServiceCatalogPortfolioShareConfig(rName, principalID string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = %[1]q
+  provider_name = "platform-team"
+}
+
+resource "aws_servicecatalog_portfolio_share" "test" {
+  portfolio_id = "${aws_servicecatalog_portfolio.test.id}"
+  principal_id = %[2]q
+}
+`, rName, principalID)
+}
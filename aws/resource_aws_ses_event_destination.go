@@ -104,8 +104,9 @@ func resourceAwsSesEventDestination() *schema.Resource {
 						},
 
 						"role_arn": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamRoleArn,
 						},
 					},
 				},
@@ -291,7 +291,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsSSM(ssmconn, d, d.Id(), ssm.ResourceTypeForTaggingDocument); err != nil {
 			return fmt.Errorf("error setting SSM Document tags: %s", err)
 		}
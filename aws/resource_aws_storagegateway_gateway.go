@@ -38,6 +38,16 @@ func resourceAwsStorageGatewayGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"average_download_rate_limit_in_bits_per_sec": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(102400),
+			},
+			"average_upload_rate_limit_in_bits_per_sec": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(51200),
+			},
 			"activation_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -262,6 +272,10 @@ func resourceAwsStorageGatewayGatewayCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if err := updateStorageGatewayGatewayBandwidthRateLimit(conn, d); err != nil {
+		return err
+	}
+
 	return resourceAwsStorageGatewayGatewayRead(d, meta)
 }
 
@@ -298,6 +312,21 @@ func resourceAwsStorageGatewayGatewayRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error reading Storage Gateway SMB Settings: %s", err)
 	}
 
+	bandwidthInput := &storagegateway.DescribeBandwidthRateLimitInput{
+		GatewayARN: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Storage Gateway Bandwidth rate limit: %s", bandwidthInput)
+	bandwidthOutput, err := conn.DescribeBandwidthRateLimit(bandwidthInput)
+	if err != nil && !isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "This operation is not valid for the specified gateway") {
+		return fmt.Errorf("error reading Storage Gateway Bandwidth rate limit: %s", err)
+	}
+
+	if bandwidthOutput != nil {
+		d.Set("average_download_rate_limit_in_bits_per_sec", aws.Int64Value(bandwidthOutput.AverageDownloadRateLimitInBitsPerSec))
+		d.Set("average_upload_rate_limit_in_bits_per_sec", aws.Int64Value(bandwidthOutput.AverageUploadRateLimitInBitsPerSec))
+	}
+
 	// The Storage Gateway API currently provides no way to read this value
 	// We allow Terraform to passthrough the configuration value into the state
 	d.Set("activation_key", d.Get("activation_key").(string))
@@ -409,6 +438,12 @@ func resourceAwsStorageGatewayGatewayUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("average_download_rate_limit_in_bits_per_sec") || d.HasChange("average_upload_rate_limit_in_bits_per_sec") {
+		if err := updateStorageGatewayGatewayBandwidthRateLimit(conn, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsStorageGatewayGatewayRead(d, meta)
 }
 
@@ -431,6 +466,51 @@ func resourceAwsStorageGatewayGatewayDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// updateStorageGatewayGatewayBandwidthRateLimit sets the configured bandwidth
+// rate limits and removes any limit that is no longer configured.
+func updateStorageGatewayGatewayBandwidthRateLimit(conn *storagegateway.StorageGateway, d *schema.ResourceData) error {
+	input := &storagegateway.UpdateBandwidthRateLimitInput{
+		GatewayARN: aws.String(d.Id()),
+	}
+	needsUpdate := false
+	var bandwidthTypesToDelete []string
+
+	if v := d.Get("average_download_rate_limit_in_bits_per_sec").(int); v > 0 {
+		input.AverageDownloadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		needsUpdate = true
+	} else if d.HasChange("average_download_rate_limit_in_bits_per_sec") {
+		bandwidthTypesToDelete = append(bandwidthTypesToDelete, "DOWNLOAD")
+	}
+
+	if v := d.Get("average_upload_rate_limit_in_bits_per_sec").(int); v > 0 {
+		input.AverageUploadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		needsUpdate = true
+	} else if d.HasChange("average_upload_rate_limit_in_bits_per_sec") {
+		bandwidthTypesToDelete = append(bandwidthTypesToDelete, "UPLOAD")
+	}
+
+	if needsUpdate {
+		log.Printf("[DEBUG] Updating Storage Gateway Bandwidth rate limit: %s", input)
+		if _, err := conn.UpdateBandwidthRateLimit(input); err != nil {
+			return fmt.Errorf("error updating Storage Gateway Bandwidth rate limit: %s", err)
+		}
+	}
+
+	for _, bandwidthType := range bandwidthTypesToDelete {
+		input := &storagegateway.DeleteBandwidthRateLimitInput{
+			BandwidthType: aws.String(bandwidthType),
+			GatewayARN:    aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Deleting Storage Gateway Bandwidth rate limit: %s", input)
+		if _, err := conn.DeleteBandwidthRateLimit(input); err != nil {
+			return fmt.Errorf("error deleting Storage Gateway Bandwidth rate limit: %s", err)
+		}
+	}
+
+	return nil
+}
+
 // The API returns multiple responses for a missing gateway
 func isAWSErrStorageGatewayGatewayNotFound(err error) bool {
 	if isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway was not found.") {
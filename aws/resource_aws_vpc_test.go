@@ -246,6 +246,38 @@ func TestAccAWSVpc_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpc_defaultTags(t *testing.T) {
+	var vpc ec2.Vpc
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcConfigDefaultTags("1234"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_vpc.foo", &vpc),
+					testAccCheckTags(&vpc.Tags, "CostCenter", "1234"),
+					testAccCheckTags(&vpc.Tags, "Name", "terraform-testacc-vpc-default-tags"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags_all.CostCenter", "1234"),
+				),
+			},
+			{
+				Config: testAccVpcConfigDefaultTags("5678"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_vpc.foo", &vpc),
+					testAccCheckTags(&vpc.Tags, "CostCenter", "5678"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags_all.CostCenter", "5678"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpc_update(t *testing.T) {
 	var vpc ec2.Vpc
 
@@ -500,6 +532,27 @@ resource "aws_vpc" "foo" {
 	}
 }
 `
+
+func testAccVpcConfigDefaultTags(costCenter string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags {
+      CostCenter = %q
+    }
+  }
+}
+
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-default-tags"
+  }
+}
+`, costCenter)
+}
+
 const testAccVpcDedicatedConfig = `
 resource "aws_vpc" "foo" {
 	instance_tenancy = "dedicated"
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -21,6 +22,10 @@ func resourceAwsWafWebAcl() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -39,6 +44,7 @@ func resourceAwsWafWebAcl() *schema.Resource {
 					},
 				},
 			},
+			"logging_configuration": wafLoggingConfigurationSchema(),
 			"metric_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -151,10 +157,34 @@ func resourceAwsWafWebAclRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("name", resp.WebACL.Name)
 	d.Set("metric_name", resp.WebACL.MetricName)
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "waf",
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("webacl/%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+
 	if err := d.Set("rules", flattenWafWebAclRules(resp.WebACL.Rules)); err != nil {
 		return fmt.Errorf("error setting rules: %s", err)
 	}
 
+	loggingConfiguration := []interface{}{}
+	getLoggingConfigurationOutput, err := conn.GetLoggingConfiguration(&waf.GetLoggingConfigurationInput{
+		ResourceArn: aws.String(arn.String()),
+	})
+	if err != nil && !isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+		return fmt.Errorf("error reading WAF ACL (%s) logging configuration: %s", d.Id(), err)
+	}
+	if err == nil && getLoggingConfigurationOutput != nil {
+		loggingConfiguration = flattenWafLoggingConfiguration(getLoggingConfigurationOutput.LoggingConfiguration)
+	}
+
+	if err := d.Set("logging_configuration", loggingConfiguration); err != nil {
+		return fmt.Errorf("error setting logging_configuration: %s", err)
+	}
+
 	return nil
 }
 
@@ -180,12 +210,52 @@ func resourceAwsWafWebAclUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("logging_configuration") {
+		resourceArn := arn.ARN{
+			Partition: meta.(*AWSClient).partition,
+			Service:   "waf",
+			AccountID: meta.(*AWSClient).accountid,
+			Resource:  fmt.Sprintf("webacl/%s", d.Id()),
+		}.String()
+
+		if l := d.Get("logging_configuration").([]interface{}); len(l) > 0 && l[0] != nil {
+			input := &waf.PutLoggingConfigurationInput{
+				LoggingConfiguration: expandWafLoggingConfiguration(l, resourceArn),
+			}
+
+			log.Printf("[DEBUG] Updating WAF ACL (%s) logging configuration: %s", d.Id(), input)
+			if _, err := conn.PutLoggingConfiguration(input); err != nil {
+				return fmt.Errorf("error updating WAF ACL (%s) logging configuration: %s", d.Id(), err)
+			}
+		} else {
+			input := &waf.DeleteLoggingConfigurationInput{
+				ResourceArn: aws.String(resourceArn),
+			}
+
+			log.Printf("[DEBUG] Deleting WAF ACL (%s) logging configuration: %s", d.Id(), input)
+			if _, err := conn.DeleteLoggingConfiguration(input); err != nil {
+				return fmt.Errorf("error deleting WAF ACL (%s) logging configuration: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceAwsWafWebAclRead(d, meta)
 }
 
 func resourceAwsWafWebAclDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).wafconn
 
+	if l := d.Get("logging_configuration").([]interface{}); len(l) > 0 && l[0] != nil {
+		input := &waf.DeleteLoggingConfigurationInput{
+			ResourceArn: aws.String(d.Get("arn").(string)),
+		}
+
+		log.Printf("[DEBUG] Deleting WAF ACL (%s) logging configuration: %s", d.Id(), input)
+		if _, err := conn.DeleteLoggingConfiguration(input); err != nil && !isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			return fmt.Errorf("error deleting WAF ACL (%s) logging configuration: %s", d.Id(), err)
+		}
+	}
+
 	// First, need to delete all rules
 	rules := d.Get("rules").(*schema.Set).List()
 	if len(rules) > 0 {
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +20,10 @@ func resourceAwsWafRegionalWebAcl() *schema.Resource {
 		Delete: resourceAwsWafRegionalWebAclDelete,
 
 		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -37,6 +42,7 @@ func resourceAwsWafRegionalWebAcl() *schema.Resource {
 					},
 				},
 			},
+			"logging_configuration": wafLoggingConfigurationSchema(),
 			"metric_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -149,10 +155,35 @@ func resourceAwsWafRegionalWebAclRead(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("name", resp.WebACL.Name)
 	d.Set("metric_name", resp.WebACL.MetricName)
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "waf-regional",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("webacl/%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+
 	if err := d.Set("rule", flattenWafWebAclRules(resp.WebACL.Rules)); err != nil {
 		return fmt.Errorf("error setting rule: %s", err)
 	}
 
+	loggingConfiguration := []interface{}{}
+	getLoggingConfigurationOutput, err := conn.GetLoggingConfiguration(&waf.GetLoggingConfigurationInput{
+		ResourceArn: aws.String(arn.String()),
+	})
+	if err != nil && !isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+		return fmt.Errorf("error reading WAF Regional ACL (%s) logging configuration: %s", d.Id(), err)
+	}
+	if err == nil && getLoggingConfigurationOutput != nil {
+		loggingConfiguration = flattenWafLoggingConfiguration(getLoggingConfigurationOutput.LoggingConfiguration)
+	}
+
+	if err := d.Set("logging_configuration", loggingConfiguration); err != nil {
+		return fmt.Errorf("error setting logging_configuration: %s", err)
+	}
+
 	return nil
 }
 
@@ -178,6 +209,37 @@ func resourceAwsWafRegionalWebAclUpdate(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Error Updating WAF Regional ACL: %s", err)
 		}
 	}
+
+	if d.HasChange("logging_configuration") {
+		resourceArn := arn.ARN{
+			Partition: meta.(*AWSClient).partition,
+			Service:   "waf-regional",
+			Region:    meta.(*AWSClient).region,
+			AccountID: meta.(*AWSClient).accountid,
+			Resource:  fmt.Sprintf("webacl/%s", d.Id()),
+		}.String()
+
+		if l := d.Get("logging_configuration").([]interface{}); len(l) > 0 && l[0] != nil {
+			input := &waf.PutLoggingConfigurationInput{
+				LoggingConfiguration: expandWafLoggingConfiguration(l, resourceArn),
+			}
+
+			log.Printf("[DEBUG] Updating WAF Regional ACL (%s) logging configuration: %s", d.Id(), input)
+			if _, err := conn.PutLoggingConfiguration(input); err != nil {
+				return fmt.Errorf("error updating WAF Regional ACL (%s) logging configuration: %s", d.Id(), err)
+			}
+		} else {
+			input := &waf.DeleteLoggingConfigurationInput{
+				ResourceArn: aws.String(resourceArn),
+			}
+
+			log.Printf("[DEBUG] Deleting WAF Regional ACL (%s) logging configuration: %s", d.Id(), input)
+			if _, err := conn.DeleteLoggingConfiguration(input); err != nil {
+				return fmt.Errorf("error deleting WAF Regional ACL (%s) logging configuration: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceAwsWafRegionalWebAclRead(d, meta)
 }
 
@@ -185,6 +247,17 @@ func resourceAwsWafRegionalWebAclDelete(d *schema.ResourceData, meta interface{}
 	conn := meta.(*AWSClient).wafregionalconn
 	region := meta.(*AWSClient).region
 
+	if l := d.Get("logging_configuration").([]interface{}); len(l) > 0 && l[0] != nil {
+		input := &waf.DeleteLoggingConfigurationInput{
+			ResourceArn: aws.String(d.Get("arn").(string)),
+		}
+
+		log.Printf("[DEBUG] Deleting WAF Regional ACL (%s) logging configuration: %s", d.Id(), input)
+		if _, err := conn.DeleteLoggingConfiguration(input); err != nil && !isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return fmt.Errorf("error deleting WAF Regional ACL (%s) logging configuration: %s", d.Id(), err)
+		}
+	}
+
 	// First, need to delete all rules
 	rules := d.Get("rule").(*schema.Set).List()
 	if len(rules) > 0 {
@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWorkspacesIpGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesIpGroupCreate,
+		Read:   resourceAwsWorkspacesIpGroupRead,
+		Update: resourceAwsWorkspacesIpGroupUpdate,
+		Delete: resourceAwsWorkspacesIpGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.CIDRNetwork(0, 32),
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsWorkspacesIpGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.CreateIpGroupInput{
+		GroupName: aws.String(d.Get("name").(string)),
+		UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.GroupDesc = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces IP Group: %s", input)
+	output, err := conn.CreateIpGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces IP Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.GroupId))
+
+	if err := setTagsWorkSpaces(conn, d); err != nil {
+		return fmt.Errorf("error setting WorkSpaces IP Group (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.DescribeIpGroupsInput{
+		GroupIds: aws.StringSlice([]string{d.Id()}),
+	}
+
+	log.Printf("[DEBUG] Reading WorkSpaces IP Group: %s", input)
+	output, err := conn.DescribeIpGroups(input)
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || len(output.Result) == 0 || output.Result[0] == nil {
+		log.Printf("[WARN] WorkSpaces IP Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ipGroup := output.Result[0]
+
+	d.Set("description", ipGroup.GroupDesc)
+	d.Set("name", ipGroup.GroupName)
+
+	if err := d.Set("rules", flattenWorkspacesIpGroupRules(ipGroup.UserRules)); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	tagsOutput, err := conn.DescribeTags(&workspaces.DescribeTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces IP Group (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapWorkSpaces(tagsOutput.TagList)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesIpGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("rules") {
+		input := &workspaces.UpdateRulesOfIpGroupInput{
+			GroupId:   aws.String(d.Id()),
+			UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces IP Group rules: %s", input)
+		if _, err := conn.UpdateRulesOfIpGroup(input); err != nil {
+			return fmt.Errorf("error updating WorkSpaces IP Group (%s) rules: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsWorkSpaces(conn, d); err != nil {
+		return fmt.Errorf("error updating WorkSpaces IP Group (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.DeleteIpGroupInput{
+		GroupId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting WorkSpaces IP Group: %s", input)
+	_, err := conn.DeleteIpGroup(input)
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWorkspacesIpGroupRules(rules []interface{}) []*workspaces.IpRuleItem {
+	result := make([]*workspaces.IpRuleItem, 0, len(rules))
+	for _, rule := range rules {
+		r := rule.(map[string]interface{})
+
+		item := &workspaces.IpRuleItem{
+			IpRule: aws.String(r["source"].(string)),
+		}
+
+		if v, ok := r["description"].(string); ok && v != "" {
+			item.RuleDesc = aws.String(v)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+func flattenWorkspacesIpGroupRules(rules []*workspaces.IpRuleItem) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"description": aws.StringValue(rule.RuleDesc),
+			"source":      aws.StringValue(rule.IpRule),
+		})
+	}
+
+	return result
+}
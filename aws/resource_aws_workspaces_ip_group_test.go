@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWorkspacesIpGroup_basic(t *testing.T) {
+	resourceName := "aws_workspaces_ip_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWorkspacesIpGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWorkspacesIpGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWorkspacesIpGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Home office"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				Config: testAccAWSWorkspacesIpGroupConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWorkspacesIpGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWorkspacesIpGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		output, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
+			GroupIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Result) == 0 {
+			return fmt.Errorf("WorkSpaces IP Group (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSWorkspacesIpGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_ip_group" {
+			continue
+		}
+
+		output, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
+			GroupIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Result) > 0 {
+			return fmt.Errorf("WorkSpaces IP Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSWorkspacesIpGroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name        = %[1]q
+  description = "Home office"
+
+  rules {
+    source      = "10.0.0.0/16"
+    description = "VPN"
+  }
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSWorkspacesIpGroupConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name        = %[1]q
+  description = "Home office"
+
+  rules {
+    source      = "10.0.0.0/16"
+    description = "VPN"
+  }
+
+  rules {
+    source = "192.168.1.0/24"
+  }
+
+  tags {
+    Name        = %[1]q
+    Environment = "test"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,384 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWorkspacesWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesWorkspaceCreate,
+		Read:   resourceAwsWorkspacesWorkspaceRead,
+		Update: resourceAwsWorkspacesWorkspaceUpdate,
+		Delete: resourceAwsWorkspacesWorkspaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"computer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"root_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"volume_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workspace_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_type_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.ComputeValue,
+								workspaces.ComputeStandard,
+								workspaces.ComputePerformance,
+								workspaces.ComputePower,
+								workspaces.ComputeGraphics,
+							}, false),
+						},
+						"root_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"running_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  workspaces.RunningModeAlwaysOn,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.RunningModeAlwaysOn,
+								workspaces.RunningModeAutoStop,
+							}, false),
+						},
+						"running_mode_auto_stop_timeout_in_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(int)
+								if value%60 != 0 {
+									errors = append(errors, fmt.Errorf("%q must be a multiple of 60 minutes, got: %d", k, value))
+								}
+								return
+							},
+						},
+						"user_volume_size_gib": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWorkspacesWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	request := &workspaces.WorkspaceRequest{
+		BundleId:                    aws.String(d.Get("bundle_id").(string)),
+		DirectoryId:                 aws.String(d.Get("directory_id").(string)),
+		RootVolumeEncryptionEnabled: aws.Bool(d.Get("root_volume_encryption_enabled").(bool)),
+		UserName:                    aws.String(d.Get("user_name").(string)),
+		UserVolumeEncryptionEnabled: aws.Bool(d.Get("user_volume_encryption_enabled").(bool)),
+		WorkspaceProperties:         expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("volume_encryption_key"); ok {
+		request.VolumeEncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		request.Tags = tagsFromMapWorkSpaces(v.(map[string]interface{}))
+	}
+
+	input := &workspaces.CreateWorkspacesInput{
+		Workspaces: []*workspaces.WorkspaceRequest{request},
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Workspace: %s", input)
+	output, err := conn.CreateWorkspaces(input)
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s", err)
+	}
+
+	// Failures for individual requests are not returned as an error
+	if len(output.FailedRequests) > 0 {
+		failure := output.FailedRequests[0]
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s: %s", aws.StringValue(failure.ErrorCode), aws.StringValue(failure.ErrorMessage))
+	}
+
+	if len(output.PendingRequests) == 0 {
+		return fmt.Errorf("error creating WorkSpaces Workspace: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.PendingRequests[0].WorkspaceId))
+
+	log.Printf("[DEBUG] Waiting for WorkSpaces Workspace (%s) to become available", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workspaces.WorkspaceStatePending},
+		Target:  []string{workspaces.WorkspaceStateAvailable},
+		Refresh: workspacesWorkspaceRefreshStateFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Delay:   1 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.DescribeWorkspacesInput{
+		WorkspaceIds: aws.StringSlice([]string{d.Id()}),
+	}
+
+	log.Printf("[DEBUG] Reading WorkSpaces Workspace: %s", input)
+	output, err := conn.DescribeWorkspaces(input)
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || len(output.Workspaces) == 0 || output.Workspaces[0] == nil {
+		log.Printf("[WARN] WorkSpaces Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	workspace := output.Workspaces[0]
+
+	if aws.StringValue(workspace.State) == workspaces.WorkspaceStateTerminated {
+		log.Printf("[WARN] WorkSpaces Workspace (%s) terminated, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bundle_id", workspace.BundleId)
+	d.Set("computer_name", workspace.ComputerName)
+	d.Set("directory_id", workspace.DirectoryId)
+	d.Set("ip_address", workspace.IpAddress)
+	d.Set("root_volume_encryption_enabled", workspace.RootVolumeEncryptionEnabled)
+	d.Set("state", workspace.State)
+	d.Set("user_name", workspace.UserName)
+	d.Set("user_volume_encryption_enabled", workspace.UserVolumeEncryptionEnabled)
+	d.Set("volume_encryption_key", workspace.VolumeEncryptionKey)
+
+	if err := d.Set("workspace_properties", flattenWorkspacesWorkspaceProperties(workspace.WorkspaceProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_properties: %s", err)
+	}
+
+	tagsOutput, err := conn.DescribeTags(&workspaces.DescribeTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Workspace (%s) tags: %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapWorkSpaces(tagsOutput.TagList)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("workspace_properties") {
+		input := &workspaces.ModifyWorkspacePropertiesInput{
+			WorkspaceId:         aws.String(d.Id()),
+			WorkspaceProperties: expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces Workspace properties: %s", input)
+		if _, err := conn.ModifyWorkspaceProperties(input); err != nil {
+			return fmt.Errorf("error updating WorkSpaces Workspace (%s) properties: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsWorkSpaces(conn, d); err != nil {
+		return fmt.Errorf("error updating WorkSpaces Workspace (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.TerminateWorkspacesInput{
+		TerminateWorkspaceRequests: []*workspaces.TerminateRequest{
+			{
+				WorkspaceId: aws.String(d.Id()),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Terminating WorkSpaces Workspace: %s", input)
+	output, err := conn.TerminateWorkspaces(input)
+	if err != nil {
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if len(output.FailedRequests) > 0 {
+		failure := output.FailedRequests[0]
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s: %s", d.Id(), aws.StringValue(failure.ErrorCode), aws.StringValue(failure.ErrorMessage))
+	}
+
+	log.Printf("[DEBUG] Waiting for WorkSpaces Workspace (%s) to terminate", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			workspaces.WorkspaceStatePending,
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateImpaired,
+			workspaces.WorkspaceStateUnhealthy,
+			workspaces.WorkspaceStateRebooting,
+			workspaces.WorkspaceStateStarting,
+			workspaces.WorkspaceStateRebuilding,
+			workspaces.WorkspaceStateMaintenance,
+			workspaces.WorkspaceStateAdminMaintenance,
+			workspaces.WorkspaceStateSuspended,
+			workspaces.WorkspaceStateUpdating,
+			workspaces.WorkspaceStateStopping,
+			workspaces.WorkspaceStateStopped,
+			workspaces.WorkspaceStateTerminating,
+		},
+		Target:  []string{workspaces.WorkspaceStateTerminated},
+		Refresh: workspacesWorkspaceRefreshStateFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Delay:   30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) to terminate: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func workspacesWorkspaceRefreshStateFunc(conn *workspaces.WorkSpaces, workspaceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
+			WorkspaceIds: aws.StringSlice([]string{workspaceID}),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Terminated workspaces eventually disappear from the results entirely
+		if output == nil || len(output.Workspaces) == 0 || output.Workspaces[0] == nil {
+			return workspaceID, workspaces.WorkspaceStateTerminated, nil
+		}
+
+		workspace := output.Workspaces[0]
+
+		return workspace, aws.StringValue(workspace.State), nil
+	}
+}
+
+func expandWorkspacesWorkspaceProperties(l []interface{}) *workspaces.WorkspaceProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	properties := &workspaces.WorkspaceProperties{
+		RunningMode: aws.String(m["running_mode"].(string)),
+	}
+
+	if v, ok := m["compute_type_name"].(string); ok && v != "" {
+		properties.ComputeTypeName = aws.String(v)
+	}
+
+	if v, ok := m["root_volume_size_gib"].(int); ok && v > 0 {
+		properties.RootVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["running_mode_auto_stop_timeout_in_minutes"].(int); ok && v > 0 {
+		properties.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["user_volume_size_gib"].(int); ok && v > 0 {
+		properties.UserVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	return properties
+}
+
+func flattenWorkspacesWorkspaceProperties(properties *workspaces.WorkspaceProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"compute_type_name":                         aws.StringValue(properties.ComputeTypeName),
+		"root_volume_size_gib":                      int(aws.Int64Value(properties.RootVolumeSizeGib)),
+		"running_mode":                              aws.StringValue(properties.RunningMode),
+		"running_mode_auto_stop_timeout_in_minutes": int(aws.Int64Value(properties.RunningModeAutoStopTimeoutInMinutes)),
+		"user_volume_size_gib":                      int(aws.Int64Value(properties.UserVolumeSizeGib)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWorkspacesWorkspace_basic(t *testing.T) {
+	resourceName := "aws_workspaces_workspace.test"
+
+	// WorkSpaces requires a directory already registered with the service
+	// and a user within it, neither of which can be created by this provider.
+	directoryID := os.Getenv("WORKSPACES_DIRECTORY_ID")
+	if directoryID == "" {
+		t.Skip("Environment variable WORKSPACES_DIRECTORY_ID is not set")
+	}
+	userName := os.Getenv("WORKSPACES_USER_NAME")
+	if userName == "" {
+		t.Skip("Environment variable WORKSPACES_USER_NAME is not set")
+	}
+	bundleID := os.Getenv("WORKSPACES_BUNDLE_ID")
+	if bundleID == "" {
+		t.Skip("Environment variable WORKSPACES_BUNDLE_ID is not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWorkspacesWorkspaceConfig(directoryID, bundleID, userName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWorkspacesWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttr(resourceName, "bundle_id", bundleID),
+					resource.TestCheckResourceAttr(resourceName, "user_name", userName),
+					resource.TestCheckResourceAttr(resourceName, "state", workspaces.WorkspaceStateAvailable),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", workspaces.RunningModeAutoStop),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "60"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+				),
+			},
+			{
+				Config: testAccAWSWorkspacesWorkspaceConfig(directoryID, bundleID, userName, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWorkspacesWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWorkspacesWorkspaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		output, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
+			WorkspaceIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Workspaces) == 0 {
+			return fmt.Errorf("WorkSpaces Workspace (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSWorkspacesWorkspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_workspace" {
+			continue
+		}
+
+		output, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
+			WorkspaceIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, workspace := range output.Workspaces {
+			if aws.StringValue(workspace.State) != workspaces.WorkspaceStateTerminated {
+				return fmt.Errorf("WorkSpaces Workspace (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSWorkspacesWorkspaceConfig(directoryID, bundleID, userName string, autoStopTimeout int) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  directory_id = %[1]q
+  bundle_id    = %[2]q
+  user_name    = %[3]q
+
+  workspace_properties {
+    running_mode                              = "AUTO_STOP"
+    running_mode_auto_stop_timeout_in_minutes = %[4]d
+  }
+
+  tags {
+    Environment = "test"
+  }
+}
+`, directoryID, bundleID, userName, autoStopTimeout)
+}
@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsXraySamplingRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsXraySamplingRuleCreate,
+		Read:   resourceAwsXraySamplingRuleRead,
+		Update: resourceAwsXraySamplingRuleUpdate,
+		Delete: resourceAwsXraySamplingRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"fixed_rate": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"host": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"http_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 9999),
+			},
+			"reservoir_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"service_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"url_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceAwsXraySamplingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	samplingRule := &xray.SamplingRule{
+		FixedRate:     aws.Float64(d.Get("fixed_rate").(float64)),
+		HTTPMethod:    aws.String(d.Get("http_method").(string)),
+		Host:          aws.String(d.Get("host").(string)),
+		Priority:      aws.Int64(int64(d.Get("priority").(int))),
+		ReservoirSize: aws.Int64(int64(d.Get("reservoir_size").(int))),
+		ResourceARN:   aws.String(d.Get("resource_arn").(string)),
+		RuleName:      aws.String(d.Get("rule_name").(string)),
+		ServiceName:   aws.String(d.Get("service_name").(string)),
+		ServiceType:   aws.String(d.Get("service_type").(string)),
+		URLPath:       aws.String(d.Get("url_path").(string)),
+		Version:       aws.Int64(int64(d.Get("version").(int))),
+	}
+
+	if v, ok := d.GetOk("attributes"); ok {
+		samplingRule.Attributes = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	input := &xray.CreateSamplingRuleInput{
+		SamplingRule: samplingRule,
+	}
+
+	log.Printf("[DEBUG] Creating XRay Sampling Rule: %s", input)
+	output, err := conn.CreateSamplingRule(input)
+	if err != nil {
+		return fmt.Errorf("error creating XRay Sampling Rule: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.SamplingRuleRecord.SamplingRule.RuleName))
+
+	return resourceAwsXraySamplingRuleRead(d, meta)
+}
+
+func resourceAwsXraySamplingRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	samplingRule, err := getXraySamplingRule(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading XRay Sampling Rule (%s): %s", d.Id(), err)
+	}
+
+	if samplingRule == nil {
+		log.Printf("[WARN] XRay Sampling Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", samplingRule.RuleARN)
+	d.Set("fixed_rate", samplingRule.FixedRate)
+	d.Set("host", samplingRule.Host)
+	d.Set("http_method", samplingRule.HTTPMethod)
+	d.Set("priority", samplingRule.Priority)
+	d.Set("reservoir_size", samplingRule.ReservoirSize)
+	d.Set("resource_arn", samplingRule.ResourceARN)
+	d.Set("rule_name", samplingRule.RuleName)
+	d.Set("service_name", samplingRule.ServiceName)
+	d.Set("service_type", samplingRule.ServiceType)
+	d.Set("url_path", samplingRule.URLPath)
+	d.Set("version", samplingRule.Version)
+
+	if err := d.Set("attributes", aws.StringValueMap(samplingRule.Attributes)); err != nil {
+		return fmt.Errorf("error setting attributes: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsXraySamplingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	samplingRuleUpdate := &xray.SamplingRuleUpdate{
+		FixedRate:     aws.Float64(d.Get("fixed_rate").(float64)),
+		HTTPMethod:    aws.String(d.Get("http_method").(string)),
+		Host:          aws.String(d.Get("host").(string)),
+		Priority:      aws.Int64(int64(d.Get("priority").(int))),
+		ReservoirSize: aws.Int64(int64(d.Get("reservoir_size").(int))),
+		RuleName:      aws.String(d.Id()),
+		ServiceName:   aws.String(d.Get("service_name").(string)),
+		ServiceType:   aws.String(d.Get("service_type").(string)),
+		URLPath:       aws.String(d.Get("url_path").(string)),
+	}
+
+	if d.HasChange("attributes") {
+		// Attributes are replaced as a whole, so send an empty map to remove them all.
+		samplingRuleUpdate.Attributes = stringMapToPointers(d.Get("attributes").(map[string]interface{}))
+	}
+
+	input := &xray.UpdateSamplingRuleInput{
+		SamplingRuleUpdate: samplingRuleUpdate,
+	}
+
+	log.Printf("[DEBUG] Updating XRay Sampling Rule: %s", input)
+	if _, err := conn.UpdateSamplingRule(input); err != nil {
+		return fmt.Errorf("error updating XRay Sampling Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsXraySamplingRuleRead(d, meta)
+}
+
+func resourceAwsXraySamplingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	input := &xray.DeleteSamplingRuleInput{
+		RuleName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting XRay Sampling Rule: %s", input)
+	_, err := conn.DeleteSamplingRule(input)
+	if isAWSErr(err, xray.ErrCodeInvalidRequestException, "does not exist") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting XRay Sampling Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getXraySamplingRule returns the sampling rule with the given name, or nil
+// when no such rule exists. There is no API to describe a single rule.
+func getXraySamplingRule(conn *xray.XRay, ruleName string) (*xray.SamplingRule, error) {
+	input := &xray.GetSamplingRulesInput{}
+
+	for {
+		output, err := conn.GetSamplingRules(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range output.SamplingRuleRecords {
+			if record.SamplingRule != nil && aws.StringValue(record.SamplingRule.RuleName) == ruleName {
+				return record.SamplingRule, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSXraySamplingRule_basic(t *testing.T) {
+	resourceName := "aws_xray_sampling_rule.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSXraySamplingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSXraySamplingRuleConfig(rName, 5, 0.3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "5"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reservoir_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "url_path", "*"),
+					resource.TestCheckResourceAttr(resourceName, "host", "*"),
+					resource.TestCheckResourceAttr(resourceName, "http_method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_rate", "0.3"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arn", "*"),
+					resource.TestCheckResourceAttr(resourceName, "service_name", "*"),
+					resource.TestCheckResourceAttr(resourceName, "service_type", "*"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.Hello", "World"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSXraySamplingRuleConfig(rName, 10, 0.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXraySamplingRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_rate", "0.5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckXraySamplingRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No XRay Sampling Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).xrayconn
+
+		rule, err := getXraySamplingRule(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if rule == nil {
+			return fmt.Errorf("XRay Sampling Rule (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSXraySamplingRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).xrayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_xray_sampling_rule" {
+			continue
+		}
+
+		rule, err := getXraySamplingRule(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if rule != nil {
+			return fmt.Errorf("XRay Sampling Rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSXraySamplingRuleConfig(rName string, priority int, fixedRate float64) string {
+	return fmt.Sprintf(`
+resource "aws_xray_sampling_rule" "test" {
+  rule_name      = %q
+  priority       = %d
+  version        = 1
+  reservoir_size = 10
+  url_path       = "*"
+  host           = "*"
+  http_method    = "GET"
+  fixed_rate     = %g
+  resource_arn   = "*"
+  service_name   = "*"
+  service_type   = "*"
+
+  attributes = {
+    Hello = "World"
+  }
+}
+`, rName, priority, fixedRate)
+}
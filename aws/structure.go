@@ -2320,8 +2320,8 @@ func flattenApiGatewayUsagePlanQuota(s *apigateway.QuotaSettings) []map[string]i
 }
 
 func buildApiGatewayInvokeURL(restApiId, region, stageName string) string {
-	return fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com/%s",
-		restApiId, region, stageName)
+	return fmt.Sprintf("https://%s.execute-api.%s.%s/%s",
+		restApiId, region, partitionDnsSuffix(region), stageName)
 }
 
 func expandCognitoSupportedLoginProviders(config map[string]interface{}) map[string]*string {
@@ -3349,6 +3349,56 @@ func flattenCognitoUserPoolSmsConfiguration(s *cognitoidentityprovider.SmsConfig
 	return []map[string]interface{}{m}
 }
 
+func expandCognitoUserPoolSoftwareTokenMfaConfiguration(l []interface{}) *cognitoidentityprovider.SoftwareTokenMfaConfigType {
+	if len(l) == 0 || l[0] == nil {
+		return &cognitoidentityprovider.SoftwareTokenMfaConfigType{
+			Enabled: aws.Bool(false),
+		}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &cognitoidentityprovider.SoftwareTokenMfaConfigType{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+}
+
+func flattenCognitoUserPoolSoftwareTokenMfaConfiguration(s *cognitoidentityprovider.SoftwareTokenMfaConfigType) []map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.BoolValue(s.Enabled),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandCognitoUserPoolAddOns(l []interface{}) *cognitoidentityprovider.UserPoolAddOnsType {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &cognitoidentityprovider.UserPoolAddOnsType{
+		AdvancedSecurityMode: aws.String(m["advanced_security_mode"].(string)),
+	}
+}
+
+func flattenCognitoUserPoolAddOns(s *cognitoidentityprovider.UserPoolAddOnsType) []map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"advanced_security_mode": aws.StringValue(s.AdvancedSecurityMode),
+	}
+
+	return []map[string]interface{}{m}
+}
+
 func expandCognitoUserPoolVerificationMessageTemplate(config map[string]interface{}) *cognitoidentityprovider.VerificationMessageTemplateType {
 	verificationMessageTemplateType := &cognitoidentityprovider.VerificationMessageTemplateType{}
 
@@ -3905,6 +3955,34 @@ func flattenMqBrokerInstances(instances []*mq.BrokerInstance) []interface{} {
 	return l
 }
 
+func expandMqLogs(l []interface{}) *mq.Logs {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	logs := &mq.Logs{
+		Audit:   aws.Bool(m["audit"].(bool)),
+		General: aws.Bool(m["general"].(bool)),
+	}
+
+	return logs
+}
+
+func flattenMqLogs(logs *mq.LogsSummary) []interface{} {
+	if logs == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"audit":   aws.BoolValue(logs.Audit),
+		"general": aws.BoolValue(logs.General),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenResourceLifecycleConfig(rlc *elasticbeanstalk.ApplicationResourceLifecycleConfig) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 
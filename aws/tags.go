@@ -33,8 +33,8 @@ func tagsSchemaComputed() *schema.Schema {
 }
 
 func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
@@ -127,10 +127,10 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 }
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// merged resource and provider default tags to be in "tags_all"
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
@@ -219,6 +219,17 @@ func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
 	return result
 }
 
+// ec2TagSpecificationsFromMap returns the tag specifications to tag an EC2
+// resource of the given type on creation.
+func ec2TagSpecificationsFromMap(m map[string]interface{}, t string) []*ec2.TagSpecification {
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(t),
+			Tags:         tagsFromMap(m),
+		},
+	}
+}
+
 // tagsToMap turns the list of tags into a map.
 func tagsToMap(ts []*ec2.Tag) map[string]string {
 	result := make(map[string]string)
@@ -334,7 +345,7 @@ func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
 func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
 	arn := d.Get("arn").(string)
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := d.GetChange("tags_all")
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
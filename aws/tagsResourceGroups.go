@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// setTags is a helper to set the tags for a resource. It expects the
+// merged resource and provider default tags to be in "tags_all"
+func setTagsResourceGroups(conn *resourcegroups.ResourceGroups, d *schema.ResourceData, arn string) error {
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
+		o := oraw.(map[string]interface{})
+		n := nraw.(map[string]interface{})
+		create, remove := diffTagsGeneric(o, n)
+
+		// Set tags
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %#v", remove)
+			keys := make([]*string, 0, len(remove))
+			for k := range remove {
+				keys = append(keys, aws.String(k))
+			}
+
+			_, err := conn.Untag(&resourcegroups.UntagInput{
+				Arn:  aws.String(arn),
+				Keys: keys,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Creating tags: %#v", create)
+
+			_, err := conn.Tag(&resourcegroups.TagInput{
+				Arn:  aws.String(arn),
+				Tags: create,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+// Maximum number of resources in a single ELB or ELBv2 DescribeTags call.
+const elbTagsBatchMaxSize = 20
+
+// tagsBatcher combines the tag lookups of resources refreshed concurrently
+// into a single API call for up to maxSize resources. A lookup is fetched
+// immediately when no other call is in progress. Lookups made while a call is
+// in progress are batched and fetched once it completes, or as soon as the
+// batch is full. Lookups of the same resource within a batch share the
+// result.
+type tagsBatcher struct {
+	maxSize int
+	fetch   func(ids []string) (map[string]interface{}, error)
+
+	mu      sync.Mutex
+	running int
+	pending *tagsBatch
+}
+
+type tagsBatch struct {
+	ids     []string
+	seen    map[string]bool
+	callers int
+	done    chan struct{}
+
+	result map[string]interface{}
+	errs   map[string]error
+}
+
+// get returns the tags of the resource, as returned by fetch.
+func (b *tagsBatcher) get(id string) (interface{}, error) {
+	b.mu.Lock()
+
+	batch := b.pending
+	if batch == nil {
+		batch = &tagsBatch{
+			seen: make(map[string]bool),
+			done: make(chan struct{}),
+		}
+		b.pending = batch
+	}
+
+	batch.callers++
+	if !batch.seen[id] {
+		batch.seen[id] = true
+		batch.ids = append(batch.ids, id)
+	}
+
+	if b.running == 0 || len(batch.ids) >= b.maxSize {
+		b.start(batch)
+	}
+
+	b.mu.Unlock()
+
+	<-batch.done
+
+	if err, ok := batch.errs[id]; ok {
+		return nil, err
+	}
+
+	return batch.result[id], nil
+}
+
+// start fetches the batch in the background. b.mu must be held.
+func (b *tagsBatcher) start(batch *tagsBatch) {
+	if b.pending == batch {
+		b.pending = nil
+	}
+	b.running++
+
+	go b.run(batch)
+}
+
+func (b *tagsBatcher) run(batch *tagsBatch) {
+	ids := batch.ids
+
+	log.Printf("[DEBUG] Looking up tags of %d resources for %d lookups", len(ids), batch.callers)
+
+	batch.errs = make(map[string]error)
+
+	result, err := b.fetch(ids)
+
+	switch {
+	case err == nil:
+		batch.result = result
+	case len(ids) == 1:
+		batch.errs[ids[0]] = err
+	default:
+		// The whole call fails when any of the resources no longer
+		// exists, so retry the resources individually.
+		log.Printf("[DEBUG] Batched tags lookup of %d resources failed, looking up individually: %s", len(ids), err)
+
+		batch.result = make(map[string]interface{}, len(ids))
+
+		for _, id := range ids {
+			result, err := b.fetch([]string{id})
+			if err != nil {
+				batch.errs[id] = err
+				continue
+			}
+			batch.result[id] = result[id]
+		}
+	}
+
+	close(batch.done)
+
+	b.mu.Lock()
+	b.running--
+	if b.pending != nil && b.running == 0 {
+		b.start(b.pending)
+	}
+	b.mu.Unlock()
+}
+
+func newElbTagsBatcher(conn *elb.ELB) *tagsBatcher {
+	return &tagsBatcher{
+		maxSize: elbTagsBatchMaxSize,
+		fetch: func(names []string) (map[string]interface{}, error) {
+			resp, err := conn.DescribeTags(&elb.DescribeTagsInput{
+				LoadBalancerNames: aws.StringSlice(names),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			result := make(map[string]interface{}, len(resp.TagDescriptions))
+			for _, td := range resp.TagDescriptions {
+				result[aws.StringValue(td.LoadBalancerName)] = td.Tags
+			}
+
+			return result, nil
+		},
+	}
+}
+
+func newElbv2TagsBatcher(conn *elbv2.ELBV2) *tagsBatcher {
+	return &tagsBatcher{
+		maxSize: elbTagsBatchMaxSize,
+		fetch: func(arns []string) (map[string]interface{}, error) {
+			resp, err := conn.DescribeTags(&elbv2.DescribeTagsInput{
+				ResourceArns: aws.StringSlice(arns),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			result := make(map[string]interface{}, len(resp.TagDescriptions))
+			for _, td := range resp.TagDescriptions {
+				result[aws.StringValue(td.ResourceArn)] = td.Tags
+			}
+
+			return result, nil
+		},
+	}
+}
+
+// describeElbTags returns the tags of the Classic Load Balancer, batching
+// concurrent lookups.
+func describeElbTags(meta interface{}, name string) ([]*elb.Tag, error) {
+	tags, err := meta.(*AWSClient).elbTagsBatcher.get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if tags == nil {
+		return nil, nil
+	}
+
+	return tags.([]*elb.Tag), nil
+}
+
+// describeElbv2Tags returns the tags of the ELBv2 resource, batching
+// concurrent lookups.
+func describeElbv2Tags(meta interface{}, arn string) ([]*elbv2.Tag, error) {
+	tags, err := meta.(*AWSClient).elbv2TagsBatcher.get(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	if tags == nil {
+		return nil, nil
+	}
+
+	return tags.([]*elbv2.Tag), nil
+}
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testTagsBatcher wraps a tagsBatcher whose first fetch blocks until release
+// is closed, so the lookups made in the meantime are batched.
+type testTagsBatcher struct {
+	*tagsBatcher
+
+	t       *testing.T
+	release chan struct{}
+	wg      sync.WaitGroup
+
+	mu    sync.Mutex
+	calls [][]string
+	errs  []error
+}
+
+func newTestTagsBatcher(t *testing.T, maxSize int) *testTagsBatcher {
+	tb := &testTagsBatcher{
+		t:       t,
+		release: make(chan struct{}),
+	}
+
+	tb.tagsBatcher = &tagsBatcher{
+		maxSize: maxSize,
+		fetch: func(ids []string) (map[string]interface{}, error) {
+			tb.mu.Lock()
+			tb.calls = append(tb.calls, append([]string{}, ids...))
+			first := len(tb.calls) == 1
+			tb.mu.Unlock()
+
+			if first {
+				<-tb.release
+			}
+
+			result := make(map[string]interface{}, len(ids))
+			for _, id := range ids {
+				result[id] = "tags-" + id
+			}
+			return result, nil
+		},
+	}
+
+	return tb
+}
+
+// lookup looks up the tags of the resource in the background.
+func (tb *testTagsBatcher) lookup(id string) {
+	tb.wg.Add(1)
+	go func() {
+		defer tb.wg.Done()
+
+		tags, err := tb.get(id)
+		if err == nil && tags != "tags-"+id {
+			err = fmt.Errorf("%s: unexpected tags: %v", id, tags)
+		}
+		if err != nil {
+			tb.mu.Lock()
+			tb.errs = append(tb.errs, err)
+			tb.mu.Unlock()
+		}
+	}()
+}
+
+// waitFor waits until the state of the batcher satisfies f.
+func (tb *testTagsBatcher) waitFor(f func(b *tagsBatcher) bool) {
+	deadline := time.Now().Add(10 * time.Second)
+
+	for {
+		tb.tagsBatcher.mu.Lock()
+		ok := f(tb.tagsBatcher)
+		tb.tagsBatcher.mu.Unlock()
+
+		if ok {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			tb.t.Fatalf("timeout waiting for tags batcher")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (tb *testTagsBatcher) callCount() int {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	return len(tb.calls)
+}
+
+// finish releases the first fetch, waits for all lookups and returns the
+// resources of each call, sorted.
+func (tb *testTagsBatcher) finish() [][]string {
+	close(tb.release)
+	tb.wg.Wait()
+
+	for _, err := range tb.errs {
+		tb.t.Fatal(err)
+	}
+
+	for _, call := range tb.calls {
+		sort.Strings(call)
+	}
+
+	return tb.calls
+}
+
+func TestTagsBatcher(t *testing.T) {
+	tb := newTestTagsBatcher(t, 3)
+
+	// A single lookup is fetched immediately.
+	tb.lookup("a")
+	tb.waitFor(func(b *tagsBatcher) bool { return b.running == 1 && b.pending == nil })
+
+	// Lookups made while a call is in progress are batched, and fetched once
+	// it completes.
+	for i, id := range []string{"b", "a", "b"} {
+		callers := i + 1
+		tb.lookup(id)
+		tb.waitFor(func(b *tagsBatcher) bool { return b.pending != nil && b.pending.callers == callers })
+	}
+
+	calls := tb.finish()
+
+	if expected := [][]string{{"a"}, {"a", "b"}}; !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected calls %v, got: %v", expected, calls)
+	}
+}
+
+func TestTagsBatcher_maxSize(t *testing.T) {
+	tb := newTestTagsBatcher(t, 3)
+
+	tb.lookup("a")
+	tb.waitFor(func(b *tagsBatcher) bool { return b.running == 1 })
+
+	// A full batch is fetched immediately, even while another call is in
+	// progress.
+	for _, id := range []string{"b", "c", "d"} {
+		tb.lookup(id)
+	}
+	tb.waitFor(func(b *tagsBatcher) bool { return b.pending == nil && tb.callCount() == 2 })
+
+	tb.lookup("e")
+	tb.waitFor(func(b *tagsBatcher) bool { return b.pending != nil && b.pending.callers == 1 })
+
+	calls := tb.finish()
+
+	if expected := [][]string{{"a"}, {"b", "c", "d"}, {"e"}}; !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected calls %v, got: %v", expected, calls)
+	}
+}
+
+func TestTagsBatcher_individualFallback(t *testing.T) {
+	b := &tagsBatcher{
+		maxSize: 2,
+		fetch: func(ids []string) (map[string]interface{}, error) {
+			for _, id := range ids {
+				if id == "deleted" {
+					return nil, errors.New("not found")
+				}
+			}
+
+			result := make(map[string]interface{}, len(ids))
+			for _, id := range ids {
+				result[id] = "tags-" + id
+			}
+			return result, nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	var existingTags interface{}
+	var existingErr, deletedErr error
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		existingTags, existingErr = b.get("existing")
+	}()
+	go func() {
+		defer wg.Done()
+		_, deletedErr = b.get("deleted")
+	}()
+	wg.Wait()
+
+	if deletedErr == nil {
+		t.Fatalf("expected an error for the deleted resource")
+	}
+
+	if existingErr != nil {
+		t.Fatalf("err: %s", existingErr)
+	}
+
+	if existingTags != "tags-existing" {
+		t.Fatalf("unexpected tags: %v", existingTags)
+	}
+}
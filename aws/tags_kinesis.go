@@ -13,13 +13,13 @@ import (
 const kinesisTagBatchLimit = 10
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// merged resource and provider default tags to be in "tags_all"
 func setTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData) error {
 
 	sn := d.Get("name").(string)
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsKinesis(tagsFromMapKinesis(o), tagsFromMapKinesis(n))
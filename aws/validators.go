@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
@@ -485,6 +486,60 @@ func validateArn(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// validateIamRoleArn validates that the value is the ARN of an IAM role, e.g.
+// arn:aws:iam::123456789012:role/example.
+func validateIamRoleArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	ws, errors = validateArn(v, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	parsedArn, err := arn.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if parsedArn.Service != "iam" || !strings.HasPrefix(parsedArn.Resource, "role/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid IAM role ARN", k, value))
+	}
+
+	return
+}
+
+func validateIamRoleName(v interface{}, k string) (ws []string, errors []error) {
+	// https://github.com/boto/botocore/blob/2485f5c/botocore/data/iam/2010-05-08/service-2.json#L8329-L8334
+	value := v.(string)
+	if len(value) > 64 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 64 characters", k))
+	}
+	if !regexp.MustCompile("^[\\w+=,.@-]*$").MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must match [\\w+=,.@-]", k))
+	}
+	return
+}
+
+func validateIamRoleNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 32 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 32 characters, name is limited to 64", k))
+	}
+	if !regexp.MustCompile("^[\\w+=,.@-]*$").MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must match [\\w+=,.@-]", k))
+	}
+	return
+}
+
 func validatePolicyStatementId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
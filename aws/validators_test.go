@@ -406,6 +406,35 @@ func TestValidateArn(t *testing.T) {
 	}
 }
 
+func TestValidateIamRoleArn(t *testing.T) {
+	validNames := []string{
+		"arn:aws:iam::123456789012:role/example",
+		"arn:aws:iam::123456789012:role/path/example",
+		"arn:aws:iam::123456789012:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling",
+		"arn:aws-us-gov:iam::123456789012:role/example",
+	}
+	for _, v := range validNames {
+		_, errors := validateIamRoleArn(v, "role_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IAM role ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"example",
+		"arn:aws:iam::123456789012:user/example",
+		"arn:aws:iam::123456789012:instance-profile/example",
+		"arn:aws:sts::123456789012:assumed-role/example/session",
+		"arn:aws:lambda:us-east-1:123456789012:function:role/example",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateIamRoleArn(v, "role_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IAM role ARN", v)
+		}
+	}
+}
+
 func TestValidatePolicyStatementId(t *testing.T) {
 	validNames := []string{
 		"YadaHereAndThere",
@@ -362,3 +362,89 @@ func resourceAwsWafRegexMatchSetTupleHash(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
+
+func wafLoggingConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"log_destination": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+				"redacted_fields": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"field_to_match": {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"data": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"type": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandWafLoggingConfiguration(l []interface{}, resourceARN string) *waf.LoggingConfiguration {
+	m := l[0].(map[string]interface{})
+
+	loggingConfiguration := &waf.LoggingConfiguration{
+		LogDestinationConfigs: []*string{aws.String(m["log_destination"].(string))},
+		ResourceArn:           aws.String(resourceARN),
+	}
+
+	if v, ok := m["redacted_fields"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		redactedFields := v[0].(map[string]interface{})
+		for _, ftm := range redactedFields["field_to_match"].(*schema.Set).List() {
+			loggingConfiguration.RedactedFields = append(loggingConfiguration.RedactedFields, expandFieldToMatch(ftm.(map[string]interface{})))
+		}
+	}
+
+	return loggingConfiguration
+}
+
+func flattenWafLoggingConfiguration(loggingConfiguration *waf.LoggingConfiguration) []interface{} {
+	if loggingConfiguration == nil || len(loggingConfiguration.LogDestinationConfigs) == 0 {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"log_destination": aws.StringValue(loggingConfiguration.LogDestinationConfigs[0]),
+		"redacted_fields": []interface{}{},
+	}
+
+	if len(loggingConfiguration.RedactedFields) > 0 {
+		fieldsToMatch := make([]interface{}, 0, len(loggingConfiguration.RedactedFields))
+		for _, ftm := range loggingConfiguration.RedactedFields {
+			fieldsToMatch = append(fieldsToMatch, flattenFieldToMatch(ftm)[0])
+		}
+
+		m["redacted_fields"] = []interface{}{
+			map[string]interface{}{
+				"field_to_match": fieldsToMatch,
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Interval of the progress logs of long-running operations when their status
+// does not change.
+const waiterProgressInterval = 1 * time.Minute
+
+// waitForStateWithProgress waits for the state change of a long-running
+// operation, such as the creation of a database cluster, like
+// StateChangeConf.WaitForState. The current status and elapsed time are logged
+// whenever the status changes and at least every waiterProgressInterval, so
+// the progress of the operation is visible in the logs. The given conf is not
+// modified.
+func waitForStateWithProgress(conf *resource.StateChangeConf, description string) (interface{}, error) {
+	start := time.Now()
+	progressConf := *conf
+
+	var lastState string
+	var lastLog time.Time
+
+	progressConf.Refresh = func() (interface{}, string, error) {
+		result, state, err := conf.Refresh()
+		if err != nil {
+			return result, state, err
+		}
+
+		now := time.Now()
+		if lastLog.IsZero() || state != lastState || now.Sub(lastLog) >= waiterProgressInterval {
+			log.Printf("[INFO] Waiting for %s: status %q, elapsed %s", description, state, now.Sub(start).Round(time.Second))
+
+			lastState = state
+			lastLog = now
+		}
+
+		return result, state, err
+	}
+
+	result, err := progressConf.WaitForState()
+	if err != nil {
+		return result, err
+	}
+
+	log.Printf("[INFO] %s completed after %s", description, time.Since(start).Round(time.Second))
+
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestWaitForStateWithProgress(t *testing.T) {
+	states := []string{"creating", "creating", "backing-up", "available"}
+	calls := 0
+
+	refresh := func() (interface{}, string, error) {
+		state := states[calls]
+		calls++
+		return state, state, nil
+	}
+
+	conf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "backing-up"},
+		Target:     []string{"available"},
+		Refresh:    refresh,
+		Timeout:    time.Minute,
+		MinTimeout: time.Millisecond,
+	}
+
+	result, err := waitForStateWithProgress(conf, "test resource creation")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if result != "available" {
+		t.Fatalf("expected result %q, got: %v", "available", result)
+	}
+
+	if calls != len(states) {
+		t.Fatalf("expected %d refreshes, got: %d", len(states), calls)
+	}
+
+	if reflect.ValueOf(conf.Refresh).Pointer() != reflect.ValueOf(refresh).Pointer() {
+		t.Fatalf("expected conf.Refresh to be left unchanged")
+	}
+}
+
+func TestWaitForStateWithProgress_error(t *testing.T) {
+	conf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			return nil, "", errors.New("test error")
+		},
+		Timeout:    time.Minute,
+		MinTimeout: time.Millisecond,
+	}
+
+	if _, err := waitForStateWithProgress(conf, "test resource creation"); err == nil {
+		t.Fatalf("expected error")
+	}
+}
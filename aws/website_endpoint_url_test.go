@@ -19,6 +19,9 @@ var websiteEndpoints = []struct {
 	{"ap-southeast-2", "bucket-name.s3-website-ap-southeast-2.amazonaws.com"},
 	{"ap-northeast-2", "bucket-name.s3-website.ap-northeast-2.amazonaws.com"},
 	{"sa-east-1", "bucket-name.s3-website-sa-east-1.amazonaws.com"},
+	{"cn-northwest-1", "bucket-name.s3-website.cn-northwest-1.amazonaws.com.cn"},
+	{"us-iso-east-1", "bucket-name.s3-website.us-iso-east-1.c2s.ic.gov"},
+	{"us-isob-east-1", "bucket-name.s3-website.us-isob-east-1.sc2s.sgov.gov"},
 }
 
 func TestWebsiteEndpointUrl(t *testing.T) {